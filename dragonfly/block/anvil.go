@@ -0,0 +1,99 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Anvil is a block affected by gravity. Anvils become more damaged as they are used.
+type Anvil struct {
+	// Facing is the direction that the long side of the anvil faces.
+	Facing world.Direction
+	// Damage is the damage of the anvil, from 0-2. An anvil with a damage of 0 is undamaged, an anvil with a
+	// damage of 2 is very damaged.
+	Damage int
+}
+
+// UseOnBlock places the anvil with its long side facing sideways from the user.
+func (a Anvil) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, a)
+	if !used {
+		return
+	}
+	a.Facing = user.Facing().Rotate90()
+	place(w, pos, a, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick makes the anvil fall if the block below it is removed.
+func (a Anvil) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	scheduleFall(a, pos, w)
+}
+
+// ScheduledTick ...
+func (a Anvil) ScheduledTick(pos world.BlockPos, w *world.World) {
+	fall(a, pos, w)
+}
+
+// Landed plays the sound of an anvil landing.
+func (a Anvil) Landed(pos world.BlockPos, w *world.World) {
+	w.PlaySound(pos.Vec3Centre(), sound.AnvilLand{})
+}
+
+// AABB ...
+func (a Anvil) AABB(world.BlockPos, *world.World) []physics.AABB {
+	if a.Facing == world.North || a.Facing == world.South {
+		return []physics.AABB{physics.NewAABB(mgl64.Vec3{0.125, 0, 0}, mgl64.Vec3{0.875, 1, 1})}
+	}
+	return []physics.AABB{physics.NewAABB(mgl64.Vec3{0, 0, 0.125}, mgl64.Vec3{1, 1, 0.875})}
+}
+
+// LightDiffusionLevel ...
+func (Anvil) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (a Anvil) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        5,
+		BlastResistance: 1200,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(Anvil{Damage: a.Damage}, 1)),
+	}
+}
+
+// EncodeItem ...
+func (a Anvil) EncodeItem() (id int32, meta int16) {
+	return 145, int16(a.Damage * 4)
+}
+
+// EncodeBlock ...
+func (a Anvil) EncodeBlock() (name string, properties map[string]interface{}) {
+	var damage string
+	switch a.Damage {
+	case 0:
+		damage = "undamaged"
+	case 1:
+		damage = "slightly_damaged"
+	case 2:
+		damage = "very_damaged"
+	default:
+		panic("invalid anvil damage")
+	}
+	return "minecraft:anvil", map[string]interface{}{"damage": damage, "direction": horizontalDirection(a.Facing)}
+}
+
+// allAnvils returns all possible states of anvils.
+func allAnvils() (anvils []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		for damage := 0; damage < 3; damage++ {
+			anvils = append(anvils, Anvil{Facing: d, Damage: damage})
+		}
+	}
+	return
+}
@@ -13,10 +13,11 @@ type Beacon struct{}
 // BreakInfo ...
 func (b Beacon) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    3,
-		Harvestable: alwaysHarvestable,
-		Effective:   nothingEffective,
-		Drops:       simpleDrops(item.NewStack(b, 1)),
+		Hardness:        3,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(b, 1)),
 	}
 }
 
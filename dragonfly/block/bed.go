@@ -0,0 +1,154 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/colour"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Bed is a block that players may sleep in to skip the night and to set their spawn point. A bed consists of
+// two blocks: A foot and a head.
+type Bed struct {
+	// Colour is the colour of the bed.
+	Colour colour.Colour
+	// Facing is the direction that the bed is facing. The head of the bed is found in this direction from
+	// the foot.
+	Facing world.Direction
+	// Head specifies if the block is the head of the bed. If false, the block is the foot.
+	Head bool
+	// Occupied specifies if a player is currently sleeping in the bed.
+	Occupied bool
+}
+
+// Sleeper represents an entity that is able to sleep in a bed, such as a player.
+type Sleeper interface {
+	// Sleep makes the entity sleep in the bed with its head at the position passed.
+	Sleep(pos world.BlockPos)
+}
+
+// UseOnBlock places the bed with its foot at the position clicked and its head in the direction that the user
+// is facing.
+func (b Bed) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, b)
+	if !used {
+		return false
+	}
+	b.Facing = user.Facing()
+	head := pos.Side(b.Facing.Face())
+	if !replaceable(w, head, b) || replaceable(w, pos.Side(world.FaceDown), b) || replaceable(w, head.Side(world.FaceDown), b) {
+		return false
+	}
+	place(w, pos, b, user, ctx)
+	if placed(ctx) {
+		b.Head = true
+		w.PlaceBlock(head, b)
+	}
+	return placed(ctx)
+}
+
+// Activate makes the user sleep in the bed if it is able to sleep.
+func (b Bed) Activate(pos world.BlockPos, _ world.Face, _ *world.World, u item.User) {
+	if sleeper, ok := u.(Sleeper); ok {
+		sleeper.Sleep(bedHead(pos, b))
+	}
+}
+
+// NeighbourUpdateTick removes the bed if the other half of the bed is removed.
+func (b Bed) NeighbourUpdateTick(pos, changed world.BlockPos, w *world.World) {
+	otherPos := bedOtherHalf(pos, b)
+	if changed != otherPos {
+		// Only changes to the other half of the bed matter. Checking the other half after any other update
+		// would break the first half of a bed while it is being placed.
+		return
+	}
+	if other, ok := w.Block(otherPos).(Bed); !ok || other.Head == b.Head || other.Facing != b.Facing {
+		w.BreakBlock(pos)
+	}
+}
+
+// bedHead returns the position of the head of the bed passed, which has one of its halves at the position
+// passed.
+func bedHead(pos world.BlockPos, b Bed) world.BlockPos {
+	if b.Head {
+		return pos
+	}
+	return pos.Side(b.Facing.Face())
+}
+
+// bedOtherHalf returns the position of the other half of the bed passed, which is at the position passed.
+func bedOtherHalf(pos world.BlockPos, b Bed) world.BlockPos {
+	if b.Head {
+		return pos.Side(b.Facing.Opposite().Face())
+	}
+	return pos.Side(b.Facing.Face())
+}
+
+// BedHalves returns the positions of the head and the foot of the bed with its head at the position passed.
+// If no complete bed is found at that position, false is returned.
+func BedHalves(pos world.BlockPos, w *world.World) (head, foot world.BlockPos, ok bool) {
+	b, ok := w.Block(pos).(Bed)
+	if !ok || !b.Head {
+		return pos, pos, false
+	}
+	foot = bedOtherHalf(pos, b)
+	if other, ok := w.Block(foot).(Bed); !ok || other.Head || other.Facing != b.Facing {
+		return pos, pos, false
+	}
+	return pos, foot, true
+}
+
+// AABB ...
+func (Bed) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return []physics.AABB{physics.NewAABB(mgl64.Vec3{}, mgl64.Vec3{1, 0.5625, 1})}
+}
+
+// BreakInfo ...
+func (b Bed) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.2,
+		BlastResistance: 0.2,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(Bed{Colour: b.Colour}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (Bed) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// DecodeNBT ...
+func (b Bed) DecodeNBT(data map[string]interface{}) interface{} {
+	if c := readByte(data, "color"); int(c) < len(colour.All()) {
+		b.Colour = colour.All()[c]
+	}
+	return b
+}
+
+// EncodeNBT ...
+func (b Bed) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{"id": "Bed", "color": b.Colour.Uint8()}
+}
+
+// EncodeItem ...
+func (b Bed) EncodeItem() (id int32, meta int16) {
+	return 355, int16(b.Colour.Uint8())
+}
+
+// EncodeBlock ...
+func (b Bed) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:bed", map[string]interface{}{"direction": horizontalDirection(b.Facing), "head_piece_bit": b.Head, "occupied_bit": b.Occupied}
+}
+
+// allBeds returns all states of a bed. The colour of a bed is not part of its state, but is stored in its
+// block entity instead.
+func allBeds() (beds []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		beds = append(beds, Bed{Facing: d}, Bed{Facing: d, Head: true})
+		beds = append(beds, Bed{Facing: d, Occupied: true}, Bed{Facing: d, Head: true, Occupied: true})
+	}
+	return
+}
@@ -0,0 +1,94 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// BeetrootSeeds are a crop that can be harvested to obtain beetroot. Beetroot seeds are planted on farmland
+// and grow into beetroot.
+type BeetrootSeeds struct {
+	// Growth is the stage of growth of the beetroot, from 0-7. Beetroot with a growth stage of 7 is fully grown.
+	Growth int
+}
+
+// GrowthStage ...
+func (b BeetrootSeeds) GrowthStage() int {
+	return b.Growth
+}
+
+// UseOnBlock plants the beetroot seeds on the farmland clicked.
+func (b BeetrootSeeds) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeCrop(pos, face, w, BeetrootSeeds{}, user, ctx)
+}
+
+// RandomTick has a chance to make the beetroot grow a stage.
+func (b BeetrootSeeds) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if b.Growth < 7 && cropGrows(b, pos, w, r) {
+		growCrop(pos, w, b, BeetrootSeeds{Growth: b.Growth + 1})
+	}
+}
+
+// BoneMeal makes the beetroot grow between two and five stages.
+func (b BeetrootSeeds) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if b.Growth == 7 {
+		return false
+	}
+	return growCrop(pos, w, b, BeetrootSeeds{Growth: boneMealGrowth(b.Growth)})
+}
+
+// NeighbourUpdateTick breaks the beetroot if the farmland below it is removed.
+func (b BeetrootSeeds) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !cropSupported(pos, w) {
+		breakBlock(b, pos, w)
+	}
+}
+
+// AABB ...
+func (BeetrootSeeds) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (BeetrootSeeds) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (BeetrootSeeds) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (b BeetrootSeeds) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			if b.Growth < 7 {
+				return []item.Stack{item.NewStack(BeetrootSeeds{}, 1)}
+			}
+			drops := []item.Stack{item.NewStack(item.Beetroot{}, 1)}
+			if n := binomial(3, 0.5714); n > 0 {
+				drops = append(drops, item.NewStack(BeetrootSeeds{}, n))
+			}
+			return drops
+		},
+	}
+}
+
+// EncodeItem ...
+func (BeetrootSeeds) EncodeItem() (id int32, meta int16) {
+	return 458, 0
+}
+
+// EncodeBlock ...
+func (b BeetrootSeeds) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:beetroot", map[string]interface{}{"growth": int32(b.Growth)}
+}
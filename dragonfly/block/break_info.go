@@ -4,8 +4,11 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/item/tool"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/go-gl/mathgl/mgl64"
 	"math"
 	"time"
+	_ "unsafe" // Imported for compiler directives.
 )
 
 // Breakable represents a block that may be broken by a player in survival mode. Blocks not include are blocks
@@ -68,6 +71,9 @@ func BreaksInstantly(b world.Block, i item.Stack) bool {
 type BreakInfo struct {
 	// Hardness is the hardness of the block, which influences the speed with which the block may be mined.
 	Hardness float64
+	// BlastResistance is the blast resistance of the block, which influences the strength that an explosion
+	// needs to have in order to destroy the block.
+	BlastResistance float64
 	// Harvestable is a function called to check if the block is harvestable using the tool passed. If the
 	// item used to break the block is not a tool, a tool.None is passed.
 	Harvestable func(t tool.Tool) bool
@@ -123,3 +129,21 @@ func simpleDrops(s ...item.Stack) func(t tool.Tool) []item.Stack {
 		return s
 	}
 }
+
+// breakBlock breaks the block at the position passed without a tool, dropping the items that the block drops
+// when broken if the DoTileDrops game rule is enabled.
+func breakBlock(b world.Block, pos world.BlockPos, w *world.World) {
+	w.BreakBlock(pos)
+	if breakable, ok := b.(Breakable); ok && w.GameRule(gamerule.DoTileDrops{}).(bool) {
+		for _, drop := range breakable.BreakInfo().Drops(tool.None{}) {
+			w.AddEntity(entity_newItem(drop, pos.Vec3Centre()))
+		}
+	}
+}
+
+// The following functions use the go:linkname directive in order to drop items without the block package
+// having to import the entity package.
+
+//go:linkname entity_newItem github.com/df-mc/dragonfly/dragonfly/entity.newItem
+//noinspection ALL
+func entity_newItem(i item.Stack, pos mgl64.Vec3) world.Entity
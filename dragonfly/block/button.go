@@ -0,0 +1,244 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// StoneButton is a redstone component that emits power for a second after being pressed.
+type StoneButton struct {
+	// Facing is the face of the block that the button is attached to.
+	Facing world.Face
+	// Pressed specifies if the button is currently pressed and emitting power.
+	Pressed bool
+}
+
+// WoodButton is a redstone component that emits power for one and a half seconds after being pressed.
+type WoodButton struct {
+	// Wood is the type of wood of the button. This field must have one of the values found in the wood
+	// package.
+	Wood wood.Wood
+	// Facing is the face of the block that the button is attached to.
+	Facing world.Face
+	// Pressed specifies if the button is currently pressed and emitting power.
+	Pressed bool
+}
+
+// AABB returns no boxes, as entities are able to walk through buttons.
+func (StoneButton) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (b StoneButton) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(StoneButton{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (StoneButton) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// HasLiquidDrops ...
+func (StoneButton) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock attaches the button to the face of the block clicked.
+func (b StoneButton) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, b)
+	if !used || !redstoneSupported(pos, face.Opposite(), w) {
+		return false
+	}
+	b.Facing = face.Opposite()
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick removes the button if the block it is attached to is removed.
+func (b StoneButton) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, b.Facing, w) {
+		w.BreakBlock(pos)
+	}
+}
+
+// Activate presses the button.
+func (b StoneButton) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	if !b.Pressed {
+		b.Pressed = true
+		setPressed(pos, b, w, time.Second)
+	}
+}
+
+// ScheduledTick releases the button.
+func (b StoneButton) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if b.Pressed {
+		b.Pressed = false
+		setReleased(pos, b, w)
+	}
+}
+
+// WeakPower ...
+func (b StoneButton) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	return pressedPower(b.Pressed, true)
+}
+
+// StrongPower ...
+func (b StoneButton) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	return pressedPower(b.Pressed, face == b.Facing)
+}
+
+// EncodeItem ...
+func (StoneButton) EncodeItem() (id int32, meta int16) {
+	return 77, 0
+}
+
+// EncodeBlock ...
+func (b StoneButton) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:stone_button", map[string]interface{}{"facing_direction": int32(b.Facing.Opposite()), "button_pressed_bit": b.Pressed}
+}
+
+// AABB returns no boxes, as entities are able to walk through buttons.
+func (WoodButton) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (b WoodButton) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(WoodButton{Wood: b.Wood}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (WoodButton) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// HasLiquidDrops ...
+func (WoodButton) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock attaches the button to the face of the block clicked.
+func (b WoodButton) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, b)
+	if !used || !redstoneSupported(pos, face.Opposite(), w) {
+		return false
+	}
+	b.Facing = face.Opposite()
+	place(w, pos, b, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick removes the button if the block it is attached to is removed.
+func (b WoodButton) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, b.Facing, w) {
+		w.BreakBlock(pos)
+	}
+}
+
+// Activate presses the button.
+func (b WoodButton) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	if !b.Pressed {
+		b.Pressed = true
+		setPressed(pos, b, w, time.Second*3/2)
+	}
+}
+
+// ScheduledTick releases the button.
+func (b WoodButton) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if b.Pressed {
+		b.Pressed = false
+		setReleased(pos, b, w)
+	}
+}
+
+// WeakPower ...
+func (b WoodButton) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	return pressedPower(b.Pressed, true)
+}
+
+// StrongPower ...
+func (b WoodButton) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	return pressedPower(b.Pressed, face == b.Facing)
+}
+
+// EncodeItem ...
+func (b WoodButton) EncodeItem() (id int32, meta int16) {
+	switch b.Wood {
+	case wood.Oak():
+		return 143, 0
+	case wood.Spruce():
+		return -144, 0
+	case wood.Birch():
+		return -141, 0
+	case wood.Jungle():
+		return -143, 0
+	case wood.Acacia():
+		return -140, 0
+	case wood.DarkOak():
+		return -142, 0
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (b WoodButton) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:" + b.Wood.String() + "_button"
+	if b.Wood == wood.Oak() {
+		name = "minecraft:wooden_button"
+	}
+	return name, map[string]interface{}{"facing_direction": int32(b.Facing.Opposite()), "button_pressed_bit": b.Pressed}
+}
+
+// setPressed sets the pressed button or pressure plate passed to the position passed and schedules an update
+// for it after the duration passed.
+func setPressed(pos world.BlockPos, b world.Block, w *world.World, d time.Duration) {
+	setRedstoneBlock(pos, b, w)
+	w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+	w.ScheduleBlockUpdate(pos, d)
+}
+
+// setReleased sets the released button or pressure plate passed to the position passed.
+func setReleased(pos world.BlockPos, b world.Block, w *world.World) {
+	setRedstoneBlock(pos, b, w)
+	w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+}
+
+// pressedPower returns the power emitted by a button or pressure plate that is pressed or not. If towards is
+// false, no power is emitted in the direction.
+func pressedPower(pressed, towards bool) int {
+	if pressed && towards {
+		return 15
+	}
+	return 0
+}
+
+// allButtons returns all possible states of buttons.
+func allButtons() (buttons []world.Block) {
+	for f := world.FaceDown; f <= world.FaceEast; f++ {
+		for _, pressed := range []bool{false, true} {
+			buttons = append(buttons, StoneButton{Facing: f, Pressed: pressed})
+			for _, w := range wood.All() {
+				buttons = append(buttons, WoodButton{Wood: w, Facing: f, Pressed: pressed})
+			}
+		}
+	}
+	return
+}
@@ -22,13 +22,19 @@ func (Carpet) AABB(world.BlockPos, *world.World) []physics.AABB {
 // BreakInfo ...
 func (c Carpet) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    0.1,
-		Harvestable: alwaysHarvestable,
-		Effective:   neverEffective,
-		Drops:       simpleDrops(item.NewStack(c, 1)),
+		Hardness:        0.1,
+		BlastResistance: 0.1,
+		Harvestable:     alwaysHarvestable,
+		Effective:       neverEffective,
+		Drops:           simpleDrops(item.NewStack(c, 1)),
 	}
 }
 
+// FuelInfo ...
+func (Carpet) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Millisecond * 3350}
+}
+
 // EncodeItem ...
 func (c Carpet) EncodeItem() (id int32, meta int16) {
 	return 171, int16(c.Colour.Uint8())
@@ -0,0 +1,90 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Carrot is a crop that can be consumed raw. Carrots are planted on farmland and grow into carrots that drop
+// more carrots when harvested.
+type Carrot struct {
+	// Growth is the stage of growth of the carrot, from 0-7. A carrot with a growth stage of 7 is fully grown.
+	Growth int
+}
+
+// GrowthStage ...
+func (c Carrot) GrowthStage() int {
+	return c.Growth
+}
+
+// UseOnBlock plants the carrot on the farmland clicked.
+func (c Carrot) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeCrop(pos, face, w, Carrot{}, user, ctx)
+}
+
+// RandomTick has a chance to make the carrot grow a stage.
+func (c Carrot) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if c.Growth < 7 && cropGrows(c, pos, w, r) {
+		growCrop(pos, w, c, Carrot{Growth: c.Growth + 1})
+	}
+}
+
+// BoneMeal makes the carrot grow between two and five stages.
+func (c Carrot) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if c.Growth == 7 {
+		return false
+	}
+	return growCrop(pos, w, c, Carrot{Growth: boneMealGrowth(c.Growth)})
+}
+
+// NeighbourUpdateTick breaks the carrot if the farmland below it is removed.
+func (c Carrot) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !cropSupported(pos, w) {
+		breakBlock(c, pos, w)
+	}
+}
+
+// AABB ...
+func (Carrot) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (Carrot) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (Carrot) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (c Carrot) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			if c.Growth < 7 {
+				return []item.Stack{item.NewStack(Carrot{}, 1)}
+			}
+			return []item.Stack{item.NewStack(Carrot{}, rand.Intn(4)+2)}
+		},
+	}
+}
+
+// EncodeItem ...
+func (Carrot) EncodeItem() (id int32, meta int16) {
+	return 391, 0
+}
+
+// EncodeBlock ...
+func (c Carrot) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:carrots", map[string]interface{}{"growth": int32(c.Growth)}
+}
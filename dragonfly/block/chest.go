@@ -12,6 +12,7 @@ import (
 	"github.com/go-gl/mathgl/mgl64"
 	"strings"
 	"sync"
+	"time"
 )
 
 // Chest is a container block which may be used to store items. Chests may also be paired to create a bigger
@@ -145,13 +146,19 @@ func (c Chest) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *
 // BreakInfo ...
 func (c Chest) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    2.5,
-		Harvestable: alwaysHarvestable,
-		Effective:   axeEffective,
-		Drops:       simpleDrops(append(c.inventory.Contents(), item.NewStack(c, 1))...),
+		Hardness:        2.5,
+		BlastResistance: 2.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(append(c.inventory.Contents(), item.NewStack(c, 1))...),
 	}
 }
 
+// FuelInfo ...
+func (Chest) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
 // Drops returns the drops of the chest. This includes all items held in the inventory and the chest itself.
 func (c Chest) Drops() []item.Stack {
 	return append(c.inventory.Contents(), item.NewStack(c, 1))
@@ -12,10 +12,11 @@ type Cobblestone struct {
 // BreakInfo ...
 func (c Cobblestone) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    2,
-		Harvestable: pickaxeHarvestable,
-		Effective:   pickaxeEffective,
-		Drops:       simpleDrops(item.NewStack(c, 1)),
+		Hardness:        2,
+		BlastResistance: 6,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(c, 1)),
 	}
 }
 
@@ -0,0 +1,196 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"time"
+)
+
+// Comparator is a redstone component that compares the power it receives from behind with the power it
+// receives from its sides. Comparators also measure how full a container behind them is.
+type Comparator struct {
+	// Facing is the direction that the comparator outputs power in. The comparator receives its main input
+	// from the opposite direction.
+	Facing world.Direction
+	// Subtract specifies if the comparator is in subtraction mode. In subtraction mode, the comparator outputs
+	// the power received from behind minus the highest power received from the sides. In comparison mode, it
+	// outputs the power received from behind if it is not lower than the power received from the sides.
+	Subtract bool
+	// Power is the power currently output by the comparator, from 0-15.
+	Power int
+}
+
+// AABB ...
+func (Comparator) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return []physics.AABB{physics.NewAABB(mgl64.Vec3{}, mgl64.Vec3{1, 0.125, 1})}
+}
+
+// BreakInfo ...
+func (c Comparator) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(Comparator{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (Comparator) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// HasLiquidDrops ...
+func (Comparator) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock places the comparator on top of the block clicked, facing away from the user.
+func (c Comparator) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, c)
+	if !used || !redstoneSupported(pos, world.FaceDown, w) {
+		return false
+	}
+	c.Facing = user.Facing()
+	place(w, pos, c, user, ctx)
+	return placed(ctx)
+}
+
+// Activate switches the comparator between comparison and subtraction mode.
+func (c Comparator) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	c.Subtract = !c.Subtract
+	if c.Subtract {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+	} else {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+	}
+	w.SetBlock(pos, c)
+	w.ScheduleBlockUpdate(pos, time.Second/10)
+}
+
+// NeighbourUpdateTick removes the comparator if the block below it is removed and schedules an update if the
+// output of the comparator changed.
+func (c Comparator) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, world.FaceDown, w) {
+		w.BreakBlock(pos)
+		return
+	}
+	if c.output(pos, w) != c.Power {
+		w.ScheduleBlockUpdate(pos, time.Second/10)
+	}
+}
+
+// ScheduledTick updates the output of the comparator.
+func (c Comparator) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if power := c.output(pos, w); power != c.Power {
+		c.Power = power
+		setRedstoneBlock(pos, c, w)
+	}
+}
+
+// output returns the power that the comparator at the position passed should output.
+func (c Comparator) output(pos world.BlockPos, w *world.World) int {
+	rear, side := c.rearPower(pos, w), c.sidePower(pos, w)
+	if c.Subtract {
+		if rear > side {
+			return rear - side
+		}
+		return 0
+	}
+	if rear >= side {
+		return rear
+	}
+	return 0
+}
+
+// rearPower returns the power that the comparator receives from behind. If the block behind the comparator
+// is a container, the power depends on how full the container is.
+func (c Comparator) rearPower(pos world.BlockPos, w *world.World) int {
+	back := c.Facing.Opposite()
+	if container, ok := w.Block(pos.Side(back.Face())).(Container); ok {
+		inv := container.Inventory()
+		if inv.Empty() {
+			return 0
+		}
+		var fullness float64
+		for _, it := range inv.All() {
+			if !it.Empty() {
+				fullness += float64(it.Count()) / float64(it.MaxCount())
+			}
+		}
+		return int(math.Floor(1 + fullness/float64(inv.Size())*14))
+	}
+	return w.RedstonePower(pos, back.Face())
+}
+
+// sidePower returns the highest power that the comparator receives from its sides. Only redstone dust,
+// repeaters, comparators and blocks of redstone power comparators from the side.
+func (c Comparator) sidePower(pos world.BlockPos, w *world.World) int {
+	power := 0
+	for _, d := range []world.Direction{c.Facing.Rotate90(), c.Facing.Rotate90().Opposite()} {
+		side := pos.Side(d.Face())
+		var p int
+		switch b := w.Block(side).(type) {
+		case RedstoneDust, Repeater, Comparator, RedstoneBlock:
+			p = b.(world.RedstoneEmitter).WeakPower(side, d.Opposite().Face(), w)
+		}
+		if p > power {
+			power = p
+		}
+	}
+	return power
+}
+
+// WeakPower ...
+func (c Comparator) WeakPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	if face == c.Facing.Face() {
+		return c.Power
+	}
+	return 0
+}
+
+// StrongPower ...
+func (c Comparator) StrongPower(pos world.BlockPos, face world.Face, w *world.World) int {
+	return c.WeakPower(pos, face, w)
+}
+
+// EncodeItem ...
+func (Comparator) EncodeItem() (id int32, meta int16) {
+	return 404, 0
+}
+
+// EncodeBlock ...
+func (c Comparator) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:unpowered_comparator"
+	if c.Power > 0 {
+		name = "minecraft:powered_comparator"
+	}
+	return name, map[string]interface{}{"direction": horizontalDirection(c.Facing.Opposite()), "output_lit_bit": c.Power > 0, "output_subtract_bit": c.Subtract}
+}
+
+// DecodeNBT ...
+func (c Comparator) DecodeNBT(data map[string]interface{}) interface{} {
+	power, _ := data["OutputSignal"].(int32)
+	c.Power = int(power)
+	return c
+}
+
+// EncodeNBT ...
+func (c Comparator) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{"id": "Comparator", "OutputSignal": int32(c.Power)}
+}
+
+// allComparators returns all possible states of comparators.
+func allComparators() (comparators []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		for _, subtract := range []bool{false, true} {
+			comparators = append(comparators, Comparator{Facing: d, Subtract: subtract}, Comparator{Facing: d, Subtract: subtract, Power: 15})
+		}
+	}
+	return
+}
@@ -16,10 +16,11 @@ type Concrete struct {
 // BreakInfo ...
 func (c Concrete) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    1.8,
-		Harvestable: pickaxeHarvestable,
-		Effective:   pickaxeEffective,
-		Drops:       simpleDrops(item.NewStack(c, 1)),
+		Hardness:        1.8,
+		BlastResistance: 1.8,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(c, 1)),
 	}
 }
 
@@ -0,0 +1,81 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/colour"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+)
+
+// ConcretePowder is a block affected by gravity that comes in the 16 regular dye colours. It turns into
+// concrete of the same colour when it comes in contact with water.
+type ConcretePowder struct {
+	// Colour is the colour of the concrete powder.
+	Colour colour.Colour
+}
+
+// NeighbourUpdateTick turns the concrete powder into concrete if it touches water and makes it fall if the
+// block below it is removed.
+func (c ConcretePowder) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if c.Solidifies(pos, w) {
+		w.SetBlock(pos, c.Solidified())
+		return
+	}
+	scheduleFall(c, pos, w)
+}
+
+// ScheduledTick ...
+func (c ConcretePowder) ScheduledTick(pos world.BlockPos, w *world.World) {
+	fall(c, pos, w)
+}
+
+// Solidifies checks if the concrete powder at the position passed touches water on any side other than the
+// bottom, or if there is water at the position itself.
+func (c ConcretePowder) Solidifies(pos world.BlockPos, w *world.World) bool {
+	for f := world.FaceUp; f <= world.FaceEast; f++ {
+		if liquid, ok := w.Liquid(pos.Side(f)); ok {
+			if _, ok := liquid.(Water); ok {
+				return true
+			}
+		}
+	}
+	liquid, ok := w.Liquid(pos)
+	if ok {
+		_, ok = liquid.(Water)
+	}
+	return ok
+}
+
+// Solidified returns concrete with the same colour as the concrete powder.
+func (c ConcretePowder) Solidified() world.Block {
+	return Concrete{Colour: c.Colour}
+}
+
+// BreakInfo ...
+func (c ConcretePowder) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       shovelEffective,
+		Drops:           simpleDrops(item.NewStack(c, 1)),
+	}
+}
+
+// EncodeItem ...
+func (c ConcretePowder) EncodeItem() (id int32, meta int16) {
+	return 237, int16(c.Colour.Uint8())
+}
+
+// EncodeBlock ...
+func (c ConcretePowder) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:concretePowder", map[string]interface{}{"color": c.Colour.String()}
+}
+
+// allConcretePowder returns concrete powder with all possible colours.
+func allConcretePowder() []world.Block {
+	b := make([]world.Block, 0, 16)
+	for _, c := range colour.All() {
+		b = append(b, ConcretePowder{Colour: c})
+	}
+	return b
+}
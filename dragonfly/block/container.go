@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/item/inventory"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"time"
 )
 
 // ContainerViewer represents a viewer that is able to view a container and its inventory.
@@ -13,6 +14,16 @@ type ContainerViewer interface {
 	ViewSlotChange(slot int, newItem item.Stack)
 }
 
+// FurnaceViewer represents a ContainerViewer that is also able to view the progress of a furnace, blast
+// furnace or smoker that it has opened.
+type FurnaceViewer interface {
+	ContainerViewer
+	// ViewFurnaceProgress views the progress of the furnace opened. The cook duration passed is the time that
+	// the current item has been smelting for, and the remaining and max fuel durations are those of the fuel
+	// that is currently burning.
+	ViewFurnaceProgress(cook, remainingFuel, maxFuel time.Duration)
+}
+
 // ContainerOpener represents an entity that is able to open a container.
 type ContainerOpener interface {
 	// OpenBlockContainer opens a block container at the position passed.
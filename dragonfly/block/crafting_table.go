@@ -0,0 +1,44 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"time"
+)
+
+// CraftingTable is a utility block that allows the player to craft a variety of blocks and items using a
+// 3x3 crafting grid.
+type CraftingTable struct{}
+
+// Activate ...
+func (CraftingTable) Activate(pos world.BlockPos, _ world.Face, _ *world.World, u item.User) {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+	}
+}
+
+// BreakInfo ...
+func (c CraftingTable) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        2.5,
+		BlastResistance: 2.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(c, 1)),
+	}
+}
+
+// FuelInfo ...
+func (CraftingTable) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
+// EncodeItem ...
+func (CraftingTable) EncodeItem() (id int32, meta int16) {
+	return 58, 0
+}
+
+// EncodeBlock ...
+func (CraftingTable) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:crafting_table", nil
+}
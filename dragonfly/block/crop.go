@@ -0,0 +1,121 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/event"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"math/rand"
+)
+
+// Crop represents a block that is planted on farmland and grows over time, such as wheat or a pumpkin stem.
+type Crop interface {
+	// GrowthStage returns the stage of growth of the crop, from 0-7. A crop with a growth stage of 7 is fully
+	// grown.
+	GrowthStage() int
+}
+
+// placeCrop places the crop passed on the farmland clicked by the user.
+func placeCrop(pos world.BlockPos, face world.Face, w *world.World, c world.Block, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, c)
+	if !used {
+		return false
+	}
+	if _, ok := w.Block(pos.Side(world.FaceDown)).(Farmland); !ok {
+		return false
+	}
+	place(w, pos, c, user, ctx)
+	return placed(ctx)
+}
+
+// cropSupported checks if the crop at the position passed is still planted on farmland.
+func cropSupported(pos world.BlockPos, w *world.World) bool {
+	_, ok := w.Block(pos.Side(world.FaceDown)).(Farmland)
+	return ok
+}
+
+// cropGrows checks if the crop at the position passed grows during a random tick. Crops only grow with a
+// light level of at least 9 and grow faster when planted on hydrated farmland, but slower when surrounded by
+// crops of the same type in more than one direction.
+func cropGrows(c world.Block, pos world.BlockPos, w *world.World, r *rand.Rand) bool {
+	if w.Light(pos) < 9 {
+		return false
+	}
+	speed := 1.0
+	for x := -1; x <= 1; x++ {
+		for z := -1; z <= 1; z++ {
+			farmland, ok := w.Block(pos.Add(world.BlockPos{x, -1, z})).(Farmland)
+			if !ok {
+				continue
+			}
+			s := 1.0
+			if farmland.Hydration > 0 {
+				s = 3
+			}
+			if x != 0 || z != 0 {
+				s /= 4
+			}
+			speed += s
+		}
+	}
+	sameCrop := func(x, z int) bool {
+		return sameBlockType(c, w.Block(pos.Add(world.BlockPos{x, 0, z})))
+	}
+	horizontal := sameCrop(-1, 0) || sameCrop(1, 0)
+	vertical := sameCrop(0, -1) || sameCrop(0, 1)
+	if (horizontal && vertical) || sameCrop(-1, -1) || sameCrop(1, -1) || sameCrop(1, 1) || sameCrop(-1, 1) {
+		speed /= 2
+	}
+	return r.Intn(int(25/speed)+1) == 0
+}
+
+// growCrop grows the crop at the position passed into the new crop passed. Handler.HandleCropGrowth is
+// called, which may cancel the growth. growCrop returns true if the crop was grown.
+func growCrop(pos world.BlockPos, w *world.World, crop, newCrop world.Block) (grown bool) {
+	ctx := event.C()
+	w.Handler().HandleCropGrowth(ctx, pos, crop, newCrop)
+	ctx.Continue(func() {
+		w.SetBlock(pos, newCrop)
+		grown = true
+	})
+	return
+}
+
+// boneMealGrowth returns the growth stage of a crop with the growth stage passed after bone meal is used on
+// it.
+func boneMealGrowth(growth int) int {
+	growth += rand.Intn(4) + 2
+	if growth > 7 {
+		return 7
+	}
+	return growth
+}
+
+// sameBlockType checks if the two blocks passed are of the same type, regardless of their properties.
+func sameBlockType(a, b world.Block) bool {
+	nameA, _ := a.EncodeBlock()
+	nameB, _ := b.EncodeBlock()
+	return nameA == nameB
+}
+
+// binomial returns a random number of successes out of n attempts, each with the chance p to succeed. It is
+// used to calculate the amount of seeds dropped by crops.
+func binomial(n int, p float64) (successes int) {
+	for i := 0; i < n; i++ {
+		if rand.Float64() < p {
+			successes++
+		}
+	}
+	return
+}
+
+// allCrops returns all possible states of crops.
+func allCrops() (crops []world.Block) {
+	for growth := 0; growth < 8; growth++ {
+		crops = append(crops, WheatSeeds{Growth: growth}, Carrot{Growth: growth}, Potato{Growth: growth}, BeetrootSeeds{Growth: growth})
+		crops = append(crops, PumpkinSeeds{Growth: growth}, MelonSeeds{Growth: growth})
+		for d := world.North; d <= world.East; d++ {
+			crops = append(crops, PumpkinSeeds{Growth: growth, Attached: true, Facing: d}, MelonSeeds{Growth: growth, Attached: true, Facing: d})
+		}
+	}
+	return
+}
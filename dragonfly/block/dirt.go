@@ -13,10 +13,11 @@ type Dirt struct {
 // BreakInfo ...
 func (d Dirt) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    0.5,
-		Harvestable: alwaysHarvestable,
-		Effective:   shovelEffective,
-		Drops:       simpleDrops(item.NewStack(d, 1)),
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       shovelEffective,
+		Drops:           simpleDrops(item.NewStack(d, 1)),
 	}
 }
 
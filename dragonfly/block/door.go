@@ -0,0 +1,335 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// WoodDoor is a two blocks high block that may be opened and closed by hand or using redstone.
+type WoodDoor struct {
+	// Wood is the type of wood of the door. This field must have one of the values found in the wood
+	// package.
+	Wood wood.Wood
+	// Facing is the direction that the user placing the door was facing. The door is placed against the side
+	// of the block closest to that user.
+	Facing world.Direction
+	// Open specifies if the door is open.
+	Open bool
+	// Top specifies if the block is the top half of the door.
+	Top bool
+	// Right specifies if the hinge of the door is on its right side.
+	Right bool
+}
+
+// IronDoor is a two blocks high block that may only be opened and closed using redstone.
+type IronDoor struct {
+	// Facing is the direction that the user placing the door was facing. The door is placed against the side
+	// of the block closest to that user.
+	Facing world.Direction
+	// Open specifies if the door is open.
+	Open bool
+	// Top specifies if the block is the top half of the door.
+	Top bool
+	// Right specifies if the hinge of the door is on its right side.
+	Right bool
+}
+
+// UseOnBlock places the door, with its top half above the position clicked. The hinge of the door is placed
+// on the side where it connects to an adjacent door or solid block.
+func (d WoodDoor) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, d)
+	if !used || !doorPlaceable(pos, d, w) {
+		return false
+	}
+	d.Facing = user.Facing()
+	d.Right = doorHingeRight(pos, d.Facing, w)
+	place(w, pos, d, user, ctx)
+	if placed(ctx) {
+		d.Top = true
+		w.PlaceBlock(pos.Side(world.FaceUp), d)
+	}
+	return placed(ctx)
+}
+
+// Activate opens or closes the door.
+func (d WoodDoor) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	d.setOpen(pos, w, !d.Open)
+}
+
+// NeighbourUpdateTick removes the door if the other half of the door is removed and opens or closes it if the
+// redstone power it receives changes.
+func (d WoodDoor) NeighbourUpdateTick(pos, changed world.BlockPos, w *world.World) {
+	if !doorSupported(pos, d.Top, w) {
+		breakDoor(d, pos, d.Top, w)
+		return
+	}
+	if powered, ok := doorPowered(pos, changed, d.Top, w); ok && powered != d.Open {
+		d.setOpen(pos, w, powered)
+	}
+}
+
+// setOpen opens or closes both halves of the door.
+func (d WoodDoor) setOpen(pos world.BlockPos, w *world.World, open bool) {
+	d.Open = open
+	w.SetBlock(pos, d)
+	d.Top = !d.Top
+	w.SetBlock(doorOtherHalf(pos, !d.Top), d)
+	w.PlaySound(pos.Vec3Centre(), doorSound(open))
+}
+
+// AABB ...
+func (d WoodDoor) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return doorBoxes(d.Facing, d.Open, d.Right)
+}
+
+// BreakInfo ...
+func (d WoodDoor) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        3,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(WoodDoor{Wood: d.Wood}, 1)),
+	}
+}
+
+// FuelInfo ...
+func (WoodDoor) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 10}
+}
+
+// LightDiffusionLevel ...
+func (WoodDoor) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// EncodeItem ...
+func (d WoodDoor) EncodeItem() (id int32, meta int16) {
+	switch d.Wood {
+	case wood.Oak():
+		return 324, 0
+	case wood.Spruce():
+		return 427, 0
+	case wood.Birch():
+		return 428, 0
+	case wood.Jungle():
+		return 429, 0
+	case wood.Acacia():
+		return 430, 0
+	case wood.DarkOak():
+		return 431, 0
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (d WoodDoor) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:" + d.Wood.String() + "_door"
+	if d.Wood == wood.Oak() {
+		name = "minecraft:wooden_door"
+	}
+	return name, doorProperties(d.Facing, d.Open, d.Top, d.Right)
+}
+
+// UseOnBlock places the door, with its top half above the position clicked. The hinge of the door is placed
+// on the side where it connects to an adjacent door or solid block.
+func (d IronDoor) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, d)
+	if !used || !doorPlaceable(pos, d, w) {
+		return false
+	}
+	d.Facing = user.Facing()
+	d.Right = doorHingeRight(pos, d.Facing, w)
+	place(w, pos, d, user, ctx)
+	if placed(ctx) {
+		d.Top = true
+		w.PlaceBlock(pos.Side(world.FaceUp), d)
+	}
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick removes the door if the other half of the door is removed and opens or closes it if the
+// redstone power it receives changes.
+func (d IronDoor) NeighbourUpdateTick(pos, changed world.BlockPos, w *world.World) {
+	if !doorSupported(pos, d.Top, w) {
+		breakDoor(d, pos, d.Top, w)
+		return
+	}
+	if powered, ok := doorPowered(pos, changed, d.Top, w); ok && powered != d.Open {
+		d.setOpen(pos, w, powered)
+	}
+}
+
+// setOpen opens or closes both halves of the door.
+func (d IronDoor) setOpen(pos world.BlockPos, w *world.World, open bool) {
+	d.Open = open
+	w.SetBlock(pos, d)
+	d.Top = !d.Top
+	w.SetBlock(doorOtherHalf(pos, !d.Top), d)
+	w.PlaySound(pos.Vec3Centre(), doorSound(open))
+}
+
+// AABB ...
+func (d IronDoor) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return doorBoxes(d.Facing, d.Open, d.Right)
+}
+
+// BreakInfo ...
+func (d IronDoor) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        5,
+		BlastResistance: 5,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(IronDoor{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (IronDoor) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// EncodeItem ...
+func (IronDoor) EncodeItem() (id int32, meta int16) {
+	return 330, 0
+}
+
+// EncodeBlock ...
+func (d IronDoor) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:iron_door", doorProperties(d.Facing, d.Open, d.Top, d.Right)
+}
+
+// doorPlaceable checks if a door may be placed with its bottom half at the position passed. The block above
+// must be replaceable and the block below must not be.
+func doorPlaceable(pos world.BlockPos, d world.Block, w *world.World) bool {
+	return replaceable(w, pos.Side(world.FaceUp), d) && !replaceable(w, pos.Side(world.FaceDown), d)
+}
+
+// doorSupported checks if the half of a door at the position passed is still supported by the other half and,
+// in case of the bottom half, by the block below.
+func doorSupported(pos world.BlockPos, top bool, w *world.World) bool {
+	if !isDoor(w.Block(doorOtherHalf(pos, top))) {
+		return false
+	}
+	return top || !replaceable(w, pos.Side(world.FaceDown), Air{})
+}
+
+// breakDoor breaks the half of a door at the position passed after it lost its support. If the other half of
+// the door is still present, the block below the door was removed and the door is dropped. If not, the other
+// half was broken first and already dropped the door, so the half passed is removed without a drop.
+func breakDoor(d world.Block, pos world.BlockPos, top bool, w *world.World) {
+	if isDoor(w.Block(doorOtherHalf(pos, top))) {
+		breakBlock(d, pos, w)
+		return
+	}
+	w.BreakBlock(pos)
+}
+
+// doorOtherHalf returns the position of the other half of a door, depending on whether the position passed
+// is the top half of the door.
+func doorOtherHalf(pos world.BlockPos, top bool) world.BlockPos {
+	if top {
+		return pos.Side(world.FaceDown)
+	}
+	return pos.Side(world.FaceUp)
+}
+
+// doorHingeRight checks if the hinge of a door placed at the position passed while facing the direction passed
+// should be on the right side. This is the case if the door is placed next to another door on the left, or
+// if the block on the left is not solid while the block on the right is.
+func doorHingeRight(pos world.BlockPos, facing world.Direction, w *world.World) bool {
+	left, right := pos.Side(facing.Rotate90().Opposite().Face()), pos.Side(facing.Rotate90().Face())
+	return isDoor(w.Block(left)) || (w.RedstoneConductor(right) && !w.RedstoneConductor(left))
+}
+
+// doorPowered checks if the door at the position passed is powered by redstone. The bool returned is false
+// if the block at the changed position is not a redstone component or a block that could carry power, in
+// which case the door should not be opened or closed.
+func doorPowered(pos, changed world.BlockPos, top bool, w *world.World) (powered bool, ok bool) {
+	if !redstoneChanged(changed, w) {
+		return false, false
+	}
+	return w.ReceivedRedstonePower(pos) > 0 || w.ReceivedRedstonePower(doorOtherHalf(pos, top)) > 0, true
+}
+
+// isDoor checks if the block passed is a door.
+func isDoor(b world.Block) bool {
+	switch b.(type) {
+	case WoodDoor, IronDoor:
+		return true
+	}
+	return false
+}
+
+// doorBoxes returns the collision boxes of a door facing the direction passed.
+func doorBoxes(facing world.Direction, open, right bool) []physics.AABB {
+	side := facing.Opposite()
+	if open {
+		side = facing.Rotate90().Opposite()
+		if right {
+			side = facing.Rotate90()
+		}
+	}
+	return []physics.AABB{panelBox(side.Face(), 0.1875)}
+}
+
+// doorProperties returns the block properties of a door with the values passed.
+func doorProperties(facing world.Direction, open, top, right bool) map[string]interface{} {
+	return map[string]interface{}{
+		"direction":       (horizontalDirection(facing) + 1) % 4,
+		"open_bit":        open,
+		"upper_block_bit": top,
+		"door_hinge_bit":  right,
+	}
+}
+
+// doorSound returns the sound played when a door, trapdoor or fence gate is opened or closed.
+func doorSound(open bool) world.Sound {
+	if open {
+		return sound.DoorOpen{}
+	}
+	return sound.DoorClose{}
+}
+
+// panelBox returns a box with the thickness passed against the face of a block passed.
+func panelBox(face world.Face, thickness float64) physics.AABB {
+	min, max := mgl64.Vec3{}, mgl64.Vec3{1, 1, 1}
+	switch face {
+	case world.FaceDown:
+		max[1] = thickness
+	case world.FaceUp:
+		min[1] = 1 - thickness
+	case world.FaceNorth:
+		max[2] = thickness
+	case world.FaceSouth:
+		min[2] = 1 - thickness
+	case world.FaceWest:
+		max[0] = thickness
+	case world.FaceEast:
+		min[0] = 1 - thickness
+	}
+	return physics.NewAABB(min, max)
+}
+
+// allDoors returns all possible states of doors.
+func allDoors() (doors []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		for _, open := range []bool{false, true} {
+			for _, top := range []bool{false, true} {
+				for _, right := range []bool{false, true} {
+					doors = append(doors, IronDoor{Facing: d, Open: open, Top: top, Right: right})
+					for _, w := range wood.All() {
+						doors = append(doors, WoodDoor{Wood: w, Facing: d, Open: open, Top: top, Right: right})
+					}
+				}
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,37 @@
+package block_test
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"testing"
+)
+
+func TestDoorDropsOnce(t *testing.T) {
+	w, _, closeWorld := newRedstoneWorld(t)
+	defer closeWorld()
+
+	bottom, top := world.BlockPos{0, 1, 0}, world.BlockPos{0, 2, 0}
+	w.SetBlock(bottom, block.IronDoor{})
+	w.SetBlock(top, block.IronDoor{Top: true})
+
+	w.BreakBlock(bottom.Side(world.FaceDown))
+	waitFor(t, func() bool {
+		_, bottomAir := w.Block(bottom).(block.Air)
+		_, topAir := w.Block(top).(block.Air)
+		return bottomAir && topAir
+	}, "door was not removed after removing the block below it")
+	waitTicks(t, w, 2)
+
+	drops := 0
+	for _, e := range w.Entities() {
+		if i, ok := e.(interface{ Item() item.Stack }); ok {
+			if _, ok := i.Item().Item().(block.IronDoor); ok {
+				drops += i.Item().Count()
+			}
+		}
+	}
+	if drops != 1 {
+		t.Fatalf("expected the door to be dropped once, got %v drops", drops)
+	}
+}
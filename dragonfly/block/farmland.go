@@ -0,0 +1,116 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/event"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Farmland is a block that crops are grown on. Farmland is created by using a hoe on dirt or grass. It
+// becomes hydrated when water is nearby, which makes crops on it grow faster.
+type Farmland struct {
+	// Hydration is how much moisture the farmland has, from 0-7. Farmland with water within four blocks is
+	// set to 7 during a random tick. Otherwise, the hydration decreases until the farmland turns back into
+	// dirt.
+	Hydration int
+}
+
+// RandomTick hydrates the farmland if water is nearby or if it is raining on it. Otherwise the farmland dries
+// out and eventually turns back into dirt if no crop is planted on it.
+func (f Farmland) RandomTick(pos world.BlockPos, w *world.World, _ *rand.Rand) {
+	if f.hydrated(pos, w) {
+		if f.Hydration != 7 {
+			f.Hydration = 7
+			w.SetBlock(pos, f)
+		}
+		return
+	}
+	if f.Hydration > 0 {
+		f.Hydration--
+		w.SetBlock(pos, f)
+		return
+	}
+	if _, ok := w.Block(pos.Side(world.FaceUp)).(Crop); !ok {
+		w.PlaceBlock(pos, Dirt{})
+	}
+}
+
+// hydrated checks if the farmland at the position passed has water within four blocks horizontally, or if it
+// is raining on the farmland.
+func (f Farmland) hydrated(pos world.BlockPos, w *world.World) bool {
+	if w.RainingAt(pos.Side(world.FaceUp)) {
+		return true
+	}
+	for x := -4; x <= 4; x++ {
+		for y := 0; y <= 1; y++ {
+			for z := -4; z <= 4; z++ {
+				if liquid, ok := w.Liquid(pos.Add(world.BlockPos{x, y, z})); ok {
+					if _, ok := liquid.(Water); ok {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// NeighbourUpdateTick turns the farmland into dirt if a solid block is placed on top of it. Blocks without
+// collision boxes, such as crops, and fence gates do not turn the farmland into dirt.
+func (f Farmland) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	above := pos.Side(world.FaceUp)
+	switch b := w.Block(above).(type) {
+	case WoodFenceGate:
+		return
+	case AABBer:
+		if len(b.AABB(above, w)) == 0 {
+			return
+		}
+	}
+	w.SetBlock(pos, Dirt{})
+}
+
+// EntityLand has a chance to trample the farmland when an entity lands on it, turning it into dirt. The
+// chance increases with the distance fallen.
+func (f Farmland) EntityLand(pos world.BlockPos, w *world.World, e world.Entity, distance float64) {
+	if rand.Float64() >= distance-0.5 {
+		return
+	}
+	ctx := event.C()
+	w.Handler().HandleFarmlandTrample(ctx, pos, e)
+	ctx.Continue(func() {
+		w.PlaceBlock(pos, Dirt{})
+	})
+}
+
+// AABB ...
+func (Farmland) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return []physics.AABB{physics.NewAABB(mgl64.Vec3{}, mgl64.Vec3{1, 0.9375, 1})}
+}
+
+// BreakInfo ...
+func (f Farmland) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.6,
+		BlastResistance: 0.6,
+		Harvestable:     alwaysHarvestable,
+		Effective:       shovelEffective,
+		Drops:           simpleDrops(item.NewStack(Dirt{}, 1)),
+	}
+}
+
+// EncodeBlock ...
+func (f Farmland) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:farmland", map[string]interface{}{"moisturized_amount": int32(f.Hydration)}
+}
+
+// allFarmland returns all possible states of farmland.
+func allFarmland() (farmland []world.Block) {
+	for hydration := 0; hydration < 8; hydration++ {
+		farmland = append(farmland, Farmland{Hydration: hydration})
+	}
+	return
+}
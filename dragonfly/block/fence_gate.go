@@ -0,0 +1,123 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// WoodFenceGate is a block that may be opened and closed by hand or using redstone. Closed fence gates are
+// one and a half blocks high, so that entities cannot jump over them.
+type WoodFenceGate struct {
+	// Wood is the type of wood of the fence gate. This field must have one of the values found in the wood
+	// package.
+	Wood wood.Wood
+	// Facing is the direction that the fence gate opens towards.
+	Facing world.Direction
+	// Open specifies if the fence gate is open.
+	Open bool
+}
+
+// UseOnBlock places the fence gate, facing the direction that the user is facing.
+func (f WoodFenceGate) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, f)
+	if !used {
+		return
+	}
+	f.Facing = user.Facing()
+	place(w, pos, f, user, ctx)
+	return placed(ctx)
+}
+
+// Activate opens or closes the fence gate. A fence gate is always opened away from the user that opens it.
+func (f WoodFenceGate) Activate(pos world.BlockPos, _ world.Face, w *world.World, u item.User) {
+	f.Open = !f.Open
+	if f.Open && f.Facing == u.Facing().Opposite() {
+		f.Facing = u.Facing()
+	}
+	w.SetBlock(pos, f)
+	w.PlaySound(pos.Vec3Centre(), doorSound(f.Open))
+}
+
+// NeighbourUpdateTick opens or closes the fence gate if the redstone power it receives changes.
+func (f WoodFenceGate) NeighbourUpdateTick(pos, changed world.BlockPos, w *world.World) {
+	if powered := w.ReceivedRedstonePower(pos) > 0; redstoneChanged(changed, w) && powered != f.Open {
+		f.Open = powered
+		w.SetBlock(pos, f)
+		w.PlaySound(pos.Vec3Centre(), doorSound(f.Open))
+	}
+}
+
+// AABB returns the collision box of a closed fence gate, which is one and a half blocks high. Open fence gates
+// have no collision.
+func (f WoodFenceGate) AABB(world.BlockPos, *world.World) []physics.AABB {
+	if f.Open {
+		return nil
+	}
+	if f.Facing == world.North || f.Facing == world.South {
+		return []physics.AABB{physics.NewAABB(mgl64.Vec3{0, 0, 0.375}, mgl64.Vec3{1, 1.5, 0.625})}
+	}
+	return []physics.AABB{physics.NewAABB(mgl64.Vec3{0.375, 0, 0}, mgl64.Vec3{0.625, 1.5, 1})}
+}
+
+// BreakInfo ...
+func (f WoodFenceGate) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        2,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(WoodFenceGate{Wood: f.Wood}, 1)),
+	}
+}
+
+// FuelInfo ...
+func (WoodFenceGate) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
+// LightDiffusionLevel ...
+func (WoodFenceGate) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// EncodeItem ...
+func (f WoodFenceGate) EncodeItem() (id int32, meta int16) {
+	switch f.Wood {
+	case wood.Oak():
+		return 107, 0
+	case wood.Spruce():
+		return 183, 0
+	case wood.Birch():
+		return 184, 0
+	case wood.Jungle():
+		return 185, 0
+	case wood.Acacia():
+		return 187, 0
+	case wood.DarkOak():
+		return 186, 0
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (f WoodFenceGate) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:" + f.Wood.String() + "_fence_gate"
+	if f.Wood == wood.Oak() {
+		name = "minecraft:fence_gate"
+	}
+	return name, map[string]interface{}{"direction": horizontalDirection(f.Facing), "open_bit": f.Open, "in_wall_bit": false}
+}
+
+// allFenceGates returns all possible states of fence gates.
+func allFenceGates() (gates []world.Block) {
+	for _, w := range wood.All() {
+		for d := world.North; d <= world.East; d++ {
+			gates = append(gates, WoodFenceGate{Wood: w, Facing: d}, WoodFenceGate{Wood: w, Facing: d, Open: true})
+		}
+	}
+	return
+}
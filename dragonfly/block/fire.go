@@ -0,0 +1,112 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"math/rand"
+)
+
+// Fire is a non-solid block that burns for a while before dying out. Fire is created by, among others,
+// lightning striking the ground.
+type Fire struct {
+	// Age is the age of the fire, a number from 0-15. Fire gets older every random tick and dies out once it
+	// reaches the maximum age.
+	Age int
+}
+
+// AABB returns no boxes, as entities are able to walk through fire.
+func (Fire) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (Fire) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(),
+	}
+}
+
+// ReplaceableBy ...
+func (Fire) ReplaceableBy(world.Block) bool {
+	return true
+}
+
+// HasLiquidDrops ...
+func (Fire) HasLiquidDrops() bool {
+	return false
+}
+
+// LightEmissionLevel ...
+func (Fire) LightEmissionLevel() uint8 {
+	return 15
+}
+
+// LightDiffusionLevel ...
+func (Fire) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// RandomTick ages the fire, making it die out when it gets too old. Fire is extinguished right away if it
+// is rained on. TNT next to the fire may be ignited by it.
+func (f Fire) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if w.RainingAt(pos) || !f.supported(pos, w) {
+		w.PlaceBlock(pos, Air{})
+		return
+	}
+	if !w.GameRule(gamerule.DoFireTick{}).(bool) {
+		return
+	}
+	pos.Neighbours(func(neighbour world.BlockPos) {
+		if t, ok := w.Block(neighbour).(TNT); ok && r.Intn(3) == 0 {
+			t.Ignite(neighbour, w)
+		}
+	})
+	if f.Age == 15 {
+		if r.Intn(4) == 0 {
+			w.PlaceBlock(pos, Air{})
+		}
+		return
+	}
+	f.Age += r.Intn(3) / 2
+	w.SetBlock(pos, f)
+}
+
+// NeighbourUpdateTick ...
+func (f Fire) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !f.supported(pos, w) {
+		w.PlaceBlock(pos, Air{})
+	}
+}
+
+// supported checks if the fire at the position passed has a block below it to burn on.
+func (Fire) supported(pos world.BlockPos, w *world.World) bool {
+	below := pos.Side(world.FaceDown)
+	if aabb, ok := w.Block(below).(AABBer); ok {
+		return len(aabb.AABB(below, w)) != 0
+	}
+	return true
+}
+
+// EncodeItem ...
+func (Fire) EncodeItem() (id int32, meta int16) {
+	return 51, 0
+}
+
+// EncodeBlock ...
+func (f Fire) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:fire", map[string]interface{}{"age": int32(f.Age)}
+}
+
+// allFire returns all possible fire blocks.
+func allFire() []world.Block {
+	b := make([]world.Block, 0, 16)
+	for i := 0; i < 16; i++ {
+		b = append(b, Fire{Age: i})
+	}
+	return b
+}
@@ -0,0 +1,106 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Furnace is a utility block used to smelt blocks and items, such as raw food, into other items. It
+// requires fuel to operate.
+// The empty value of Furnace is not valid. It must be created using block.NewFurnace().
+type Furnace struct {
+	*smelter
+
+	// Facing is the direction that the furnace is facing.
+	Facing world.Direction
+	// Lit specifies if the furnace is lit, meaning it is currently burning fuel.
+	Lit bool
+}
+
+// NewFurnace creates a new initialised furnace facing the direction passed. Its inventory is properly
+// initialised.
+func NewFurnace(face world.Direction) Furnace {
+	return Furnace{smelter: newSmelter(), Facing: face}
+}
+
+// Tick smelts the item in the input slot of the furnace using the fuel in the fuel slot, lighting or
+// extinguishing the furnace as the fuel starts or stops burning.
+func (f Furnace) Tick(_ int64, pos world.BlockPos, w *world.World) {
+	if lit := f.tickSmelting(furnaceCookDuration, "furnace"); lit != f.Lit {
+		f.Lit = lit
+		w.SetBlock(pos, f)
+	}
+}
+
+// Activate ...
+func (f Furnace) Activate(pos world.BlockPos, _ world.Face, _ *world.World, u item.User) {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+	}
+}
+
+// UseOnBlock ...
+func (f Furnace) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, f)
+	if !used {
+		return
+	}
+	place(w, pos, NewFurnace(user.Facing().Opposite()), user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (f Furnace) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        3.5,
+		BlastResistance: 3.5,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(append(f.drops(), item.NewStack(Furnace{}, 1))...),
+	}
+}
+
+// LightEmissionLevel ...
+func (f Furnace) LightEmissionLevel() uint8 {
+	if f.Lit {
+		return 13
+	}
+	return 0
+}
+
+// DecodeNBT ...
+func (f Furnace) DecodeNBT(data map[string]interface{}) interface{} {
+	f.smelter = newSmelter()
+	f.decodeNBT(data)
+	return f
+}
+
+// EncodeNBT ...
+func (f Furnace) EncodeNBT() map[string]interface{} {
+	if f.smelter == nil {
+		f.smelter = newSmelter()
+	}
+	return f.encodeNBT("Furnace")
+}
+
+// EncodeItem ...
+func (f Furnace) EncodeItem() (id int32, meta int16) {
+	return 61, 0
+}
+
+// EncodeBlock ...
+func (f Furnace) EncodeBlock() (name string, properties map[string]interface{}) {
+	if f.Lit {
+		return "minecraft:lit_furnace", map[string]interface{}{"facing_direction": 2 + int32(f.Facing)}
+	}
+	return "minecraft:furnace", map[string]interface{}{"facing_direction": 2 + int32(f.Facing)}
+}
+
+// allFurnaces returns all states of the furnace.
+func allFurnaces() (b []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		b = append(b, Furnace{Facing: d}, Furnace{Facing: d, Lit: true})
+	}
+	return
+}
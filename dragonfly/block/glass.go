@@ -10,8 +10,9 @@ type Glass struct{}
 // BreakInfo ...
 func (g Glass) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness: 0.3,
-		Drops:    simpleDrops(),
+		Hardness:        0.3,
+		BlastResistance: 0.3,
+		Drops:           simpleDrops(),
 		Harvestable: func(t tool.Tool) bool {
 			return true
 		},
@@ -18,10 +18,11 @@ type GlazedTerracotta struct {
 // BreakInfo ...
 func (t GlazedTerracotta) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    1.4,
-		Harvestable: pickaxeHarvestable,
-		Effective:   pickaxeEffective,
-		Drops:       simpleDrops(item.NewStack(t, 1)),
+		Hardness:        1.4,
+		BlastResistance: 1.4,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(t, 1)),
 	}
 }
 
@@ -11,7 +11,8 @@ type GoldBlock struct{}
 // BreakInfo ...
 func (g GoldBlock) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness: 5,
+		Hardness:        5,
+		BlastResistance: 6,
 		Harvestable: func(t tool.Tool) bool {
 			return t.ToolType() == tool.TypePickaxe && t.HarvestLevel() >= tool.TierIron.HarvestLevel
 		},
@@ -0,0 +1,32 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+)
+
+// GoldOre is an ore block found underground. It may be smelted into gold ingots in a furnace or blast furnace.
+type GoldOre struct{}
+
+// BreakInfo ...
+func (o GoldOre) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        3,
+		BlastResistance: 3,
+		Harvestable: func(t tool.Tool) bool {
+			return t.ToolType() == tool.TypePickaxe && t.HarvestLevel() >= tool.TierIron.HarvestLevel
+		},
+		Effective: pickaxeEffective,
+		Drops:     simpleDrops(item.NewStack(o, 1)),
+	}
+}
+
+// EncodeItem ...
+func (o GoldOre) EncodeItem() (id int32, meta int16) {
+	return 14, 0
+}
+
+// EncodeBlock ...
+func (o GoldOre) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:gold_ore", nil
+}
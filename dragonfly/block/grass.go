@@ -59,10 +59,11 @@ func (g Grass) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
 // BreakInfo ...
 func (g Grass) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    0.6,
-		Harvestable: alwaysHarvestable,
-		Effective:   shovelEffective,
-		Drops:       simpleDrops(item.NewStack(Dirt{}, 1)),
+		Hardness:        0.6,
+		BlastResistance: 0.6,
+		Harvestable:     alwaysHarvestable,
+		Effective:       shovelEffective,
+		Drops:           simpleDrops(item.NewStack(Dirt{}, 1)),
 	}
 }
 
@@ -0,0 +1,47 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"math/rand"
+)
+
+// Gravel is a block affected by gravity. It has a chance to drop flint when broken.
+type Gravel struct{}
+
+// NeighbourUpdateTick makes the gravel fall if the block below it is removed.
+func (g Gravel) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	scheduleFall(g, pos, w)
+}
+
+// ScheduledTick ...
+func (g Gravel) ScheduledTick(pos world.BlockPos, w *world.World) {
+	fall(g, pos, w)
+}
+
+// BreakInfo ...
+func (g Gravel) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.6,
+		BlastResistance: 0.6,
+		Harvestable:     alwaysHarvestable,
+		Effective:       shovelEffective,
+		Drops: func(t tool.Tool) []item.Stack {
+			if rand.Float64() < 0.1 {
+				return []item.Stack{item.NewStack(item.Flint{}, 1)}
+			}
+			return []item.Stack{item.NewStack(g, 1)}
+		},
+	}
+}
+
+// EncodeItem ...
+func (Gravel) EncodeItem() (id int32, meta int16) {
+	return 13, 0
+}
+
+// EncodeBlock ...
+func (Gravel) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:gravel", nil
+}
@@ -0,0 +1,48 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// Solidifiable represents a block that solidifies when it comes in contact with specific blocks, such as
+// concrete powder, which turns into concrete when it touches water.
+type Solidifiable interface {
+	// Solidifies checks if the block solidifies at the position passed. A falling block that solidifies stops
+	// falling immediately.
+	Solidifies(pos world.BlockPos, w *world.World) bool
+	// Solidified returns the block that the block turns into when it solidifies.
+	Solidified() world.Block
+}
+
+// Landable represents a block that reacts to landing after falling as a falling block, such as an anvil.
+type Landable interface {
+	// Landed is called when the block lands at the position passed after falling.
+	Landed(pos world.BlockPos, w *world.World)
+}
+
+// scheduleFall schedules the gravity affected block at the position passed to fall if the block below it is
+// replaceable.
+func scheduleFall(b world.Block, pos world.BlockPos, w *world.World) {
+	if pos[1] > 0 && replaceable(w, pos.Side(world.FaceDown), b) {
+		w.ScheduleBlockUpdate(pos, time.Second/10)
+	}
+}
+
+// fall replaces the gravity affected block at the position passed with a falling block if the block below
+// it is still replaceable.
+func fall(b world.Block, pos world.BlockPos, w *world.World) {
+	if pos[1] > 0 && replaceable(w, pos.Side(world.FaceDown), b) {
+		w.PlaceBlock(pos, Air{})
+		w.AddEntity(entity_newFallingBlock(b, pos.Vec3Middle()))
+	}
+}
+
+// The following functions use the go:linkname directive in order to create falling blocks without the block
+// package having to import the entity package.
+
+//go:linkname entity_newFallingBlock github.com/df-mc/dragonfly/dragonfly/entity.newFallingBlock
+//noinspection ALL
+func entity_newFallingBlock(b world.Block, pos mgl64.Vec3) world.Entity
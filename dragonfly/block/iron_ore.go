@@ -0,0 +1,32 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+)
+
+// IronOre is an ore block found underground. It may be smelted into iron ingots in a furnace or blast furnace.
+type IronOre struct{}
+
+// BreakInfo ...
+func (o IronOre) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        3,
+		BlastResistance: 3,
+		Harvestable: func(t tool.Tool) bool {
+			return t.ToolType() == tool.TypePickaxe && t.HarvestLevel() >= tool.TierStone.HarvestLevel
+		},
+		Effective: pickaxeEffective,
+		Drops:     simpleDrops(item.NewStack(o, 1)),
+	}
+}
+
+// EncodeItem ...
+func (o IronOre) EncodeItem() (id int32, meta int16) {
+	return 15, 0
+}
+
+// EncodeBlock ...
+func (o IronOre) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:iron_ore", nil
+}
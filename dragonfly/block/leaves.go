@@ -2,8 +2,11 @@ package block
 
 import (
 	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/item/tool"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
 )
 
 // Leaves are blocks that grow as part of trees which mainly drop saplings and sticks.
@@ -18,16 +21,98 @@ type Leaves struct {
 	shouldUpdate bool
 }
 
+// UseOnBlock places persistent leaves, which never decay.
+func (l Leaves) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, l)
+	if !used {
+		return
+	}
+	l.Persistent = true
+	place(w, pos, l, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick marks non-persistent leaves to be checked for decay during the next random tick.
+func (l Leaves) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !l.Persistent && !l.shouldUpdate {
+		l.shouldUpdate = true
+		w.SetBlock(pos, l)
+	}
+}
+
+// RandomTick makes leaves that were marked for an update decay if they are too far away from a log.
+func (l Leaves) RandomTick(pos world.BlockPos, w *world.World, _ *rand.Rand) {
+	if l.Persistent || !l.shouldUpdate {
+		return
+	}
+	if logDistance(pos, w) <= maxLogDistance {
+		l.shouldUpdate = false
+		w.SetBlock(pos, l)
+		return
+	}
+	breakBlock(l, pos, w)
+}
+
+// maxLogDistance is the maximum distance in blocks that leaves may be away from a log, following other
+// leaves, without decaying.
+const maxLogDistance = 6
+
+// logDistance returns the distance of the leaves at the position passed to the nearest log, following only
+// other leaves. If no log is found within maxLogDistance, maxLogDistance+1 is returned.
+func logDistance(pos world.BlockPos, w *world.World) int {
+	visited := map[world.BlockPos]struct{}{pos: {}}
+	queue := []world.BlockPos{pos}
+	for distance := 1; distance <= maxLogDistance; distance++ {
+		var next []world.BlockPos
+		for _, p := range queue {
+			for f := world.FaceDown; f <= world.FaceEast; f++ {
+				side := p.Side(f)
+				if _, ok := visited[side]; ok {
+					continue
+				}
+				visited[side] = struct{}{}
+				switch w.Block(side).(type) {
+				case Log:
+					return distance
+				case Leaves:
+					next = append(next, side)
+				}
+			}
+		}
+		queue = next
+	}
+	return maxLogDistance + 1
+}
+
 // BreakInfo ...
 func (l Leaves) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    0.2,
-		Harvestable: alwaysHarvestable,
+		Hardness:        0.2,
+		BlastResistance: 0.2,
+		Harvestable:     alwaysHarvestable,
 		Effective: func(t tool.Tool) bool {
 			return t.ToolType() == tool.TypeShears || t.ToolType() == tool.TypeHoe
 		},
-		// TODO: Add saplings and apples and drop them here.
-		Drops: simpleDrops(),
+		Drops: func(t tool.Tool) []item.Stack {
+			if t.ToolType() == tool.TypeShears {
+				return []item.Stack{item.NewStack(Leaves{Wood: l.Wood, Persistent: true}, 1)}
+			}
+			var drops []item.Stack
+			saplingChance := 20
+			if l.Wood == wood.Jungle() {
+				saplingChance = 40
+			}
+			if rand.Intn(saplingChance) == 0 {
+				drops = append(drops, item.NewStack(Sapling{Wood: l.Wood}, 1))
+			}
+			if rand.Intn(50) == 0 {
+				drops = append(drops, item.NewStack(item.Stick{}, rand.Intn(2)+1))
+			}
+			if (l.Wood == wood.Oak() || l.Wood == wood.DarkOak()) && rand.Intn(200) == 0 {
+				drops = append(drops, item.NewStack(item.Apple{}, 1))
+			}
+			return drops
+		},
 	}
 }
 
@@ -0,0 +1,139 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Lever is a redstone component that may be switched on and off. A lever that is switched on emits power to
+// the blocks around it and strongly powers the block that it is attached to.
+type Lever struct {
+	// Facing is the face of the block that the lever is attached to.
+	Facing world.Face
+	// Axis is the horizontal axis that a lever attached to the top or bottom of a block is aligned with. It is
+	// either world.X or world.Z and is unused for levers attached to the side of a block.
+	Axis world.Axis
+	// Powered specifies if the lever is switched on.
+	Powered bool
+}
+
+// AABB returns no boxes, as entities are able to walk through levers.
+func (Lever) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (l Lever) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(Lever{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (Lever) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// HasLiquidDrops ...
+func (Lever) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock attaches the lever to the face of the block clicked.
+func (l Lever) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, l)
+	if !used {
+		return false
+	}
+	l.Facing = face.Opposite()
+	if !redstoneSupported(pos, l.Facing, w) {
+		return false
+	}
+	if l.Facing == world.FaceDown || l.Facing == world.FaceUp {
+		l.Axis = world.X
+		if d := user.Facing(); d == world.North || d == world.South {
+			l.Axis = world.Z
+		}
+	}
+	place(w, pos, l, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick removes the lever if the block it is attached to is removed.
+func (l Lever) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, l.Facing, w) {
+		w.BreakBlock(pos)
+	}
+}
+
+// Activate switches the lever on or off.
+func (l Lever) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	l.Powered = !l.Powered
+	setRedstoneBlock(pos, l, w)
+	if l.Powered {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+		return
+	}
+	w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+}
+
+// WeakPower ...
+func (l Lever) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	if l.Powered {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (l Lever) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	if l.Powered && face == l.Facing {
+		return 15
+	}
+	return 0
+}
+
+// EncodeItem ...
+func (Lever) EncodeItem() (id int32, meta int16) {
+	return 69, 0
+}
+
+// EncodeBlock ...
+func (l Lever) EncodeBlock() (name string, properties map[string]interface{}) {
+	var direction string
+	switch l.Facing {
+	case world.FaceDown:
+		direction = "up_east_west"
+		if l.Axis == world.Z {
+			direction = "up_north_south"
+		}
+	case world.FaceUp:
+		direction = "down_east_west"
+		if l.Axis == world.Z {
+			direction = "down_north_south"
+		}
+	default:
+		direction = attachedFaceDirection(l.Facing)
+	}
+	return "minecraft:lever", map[string]interface{}{"lever_direction": direction, "open_bit": l.Powered}
+}
+
+// allLevers returns all possible states of levers.
+func allLevers() (levers []world.Block) {
+	for _, powered := range []bool{false, true} {
+		for _, f := range []world.Face{world.FaceNorth, world.FaceSouth, world.FaceWest, world.FaceEast} {
+			levers = append(levers, Lever{Facing: f, Powered: powered})
+		}
+		for _, f := range []world.Face{world.FaceDown, world.FaceUp} {
+			levers = append(levers, Lever{Facing: f, Axis: world.X, Powered: powered}, Lever{Facing: f, Axis: world.Z, Powered: powered})
+		}
+	}
+	return
+}
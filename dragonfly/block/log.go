@@ -5,6 +5,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"time"
 )
 
 // Log is a naturally occurring block found in trees, primarily used to create planks. It comes in six
@@ -35,13 +36,19 @@ func (l Log) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *wo
 // BreakInfo ...
 func (l Log) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    2,
-		Harvestable: alwaysHarvestable,
-		Effective:   axeEffective,
-		Drops:       simpleDrops(item.NewStack(l, 1)),
+		Hardness:        2,
+		BlastResistance: 2,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(l, 1)),
 	}
 }
 
+// FuelInfo ...
+func (Log) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
 // EncodeItem ...
 func (l Log) EncodeItem() (id int32, meta int16) {
 	switch l.Wood {
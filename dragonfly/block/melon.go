@@ -0,0 +1,33 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"math/rand"
+)
+
+// Melon is a crop block grown by melon stems. Melons drop melon slices when broken.
+type Melon struct{}
+
+// BreakInfo ...
+func (m Melon) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        1,
+		BlastResistance: 1,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			return []item.Stack{item.NewStack(item.MelonSlice{}, rand.Intn(5)+3)}
+		},
+	}
+}
+
+// EncodeItem ...
+func (Melon) EncodeItem() (id int32, meta int16) {
+	return 103, 0
+}
+
+// EncodeBlock ...
+func (Melon) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:melon_block", nil
+}
@@ -0,0 +1,110 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// MelonSeeds are a crop that grows into a melon stem. A fully grown melon stem grows melons on the
+// blocks next to it.
+type MelonSeeds struct {
+	// Growth is the stage of growth of the stem, from 0-7. A stem with a growth stage of 7 is fully grown and
+	// grows melons next to it.
+	Growth int
+	// Attached specifies if the stem is attached to a melon that it grew.
+	Attached bool
+	// Facing is the direction of the melon that the stem is attached to. It is only used if Attached is
+	// true.
+	Facing world.Direction
+}
+
+// GrowthStage ...
+func (m MelonSeeds) GrowthStage() int {
+	return m.Growth
+}
+
+// UseOnBlock plants the melon seeds on the farmland clicked.
+func (m MelonSeeds) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeCrop(pos, face, w, MelonSeeds{}, user, ctx)
+}
+
+// RandomTick has a chance to make the stem grow a stage, or to grow a melon next to it if the stem is fully
+// grown.
+func (m MelonSeeds) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if m.Attached || !cropGrows(m, pos, w, r) {
+		return
+	}
+	if m.Growth < 7 {
+		growCrop(pos, w, m, MelonSeeds{Growth: m.Growth + 1})
+		return
+	}
+	if d, ok := growStemFruit(pos, w, r, Melon{}); ok {
+		m.Attached, m.Facing = true, d
+		w.SetBlock(pos, m)
+	}
+}
+
+// BoneMeal makes the stem grow between two and five stages.
+func (m MelonSeeds) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if m.Growth == 7 {
+		return false
+	}
+	return growCrop(pos, w, m, MelonSeeds{Growth: boneMealGrowth(m.Growth)})
+}
+
+// NeighbourUpdateTick breaks the stem if the farmland below it is removed and detaches it if the melon it
+// is attached to is removed.
+func (m MelonSeeds) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !cropSupported(pos, w) {
+		breakBlock(m, pos, w)
+		return
+	}
+	if _, ok := w.Block(pos.Side(m.Facing.Face())).(Melon); m.Attached && !ok {
+		w.SetBlock(pos, MelonSeeds{Growth: m.Growth})
+	}
+}
+
+// AABB ...
+func (MelonSeeds) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (MelonSeeds) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (MelonSeeds) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (m MelonSeeds) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			if n := stemDrops(m.Growth); n > 0 {
+				return []item.Stack{item.NewStack(MelonSeeds{}, n)}
+			}
+			return nil
+		},
+	}
+}
+
+// EncodeItem ...
+func (MelonSeeds) EncodeItem() (id int32, meta int16) {
+	return 362, 0
+}
+
+// EncodeBlock ...
+func (m MelonSeeds) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:melon_stem", stemProperties(m.Growth, m.Attached, m.Facing)
+}
@@ -22,7 +22,8 @@ func (Obsidian) EncodeBlock() (name string, properties map[string]interface{}) {
 // BreakInfo ...
 func (o Obsidian) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness: 50,
+		Hardness:        50,
+		BlastResistance: 1200,
 		Harvestable: func(t tool.Tool) bool {
 			return t.ToolType() == tool.TypePickaxe && t.HarvestLevel() >= tool.TierDiamond.HarvestLevel
 		},
@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/block/wood"
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"time"
 )
 
 // Planks are common blocks used in crafting recipes. They are made by crafting logs into planks.
@@ -16,13 +17,19 @@ type Planks struct {
 // BreakInfo ...
 func (p Planks) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    2,
-		Harvestable: alwaysHarvestable,
-		Effective:   axeEffective,
-		Drops:       simpleDrops(item.NewStack(p, 1)),
+		Hardness:        2,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(p, 1)),
 	}
 }
 
+// FuelInfo ...
+func (Planks) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
 // EncodeItem ...
 func (p Planks) EncodeItem() (id int32, meta int16) {
 	switch p.Wood {
@@ -0,0 +1,94 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Potato is a crop that can be consumed raw or cooked to make baked potatoes. Potatoes are planted on farmland
+// and grow into potatoes that drop more potatoes when harvested.
+type Potato struct {
+	// Growth is the stage of growth of the potato, from 0-7. A potato with a growth stage of 7 is fully grown.
+	Growth int
+}
+
+// GrowthStage ...
+func (p Potato) GrowthStage() int {
+	return p.Growth
+}
+
+// UseOnBlock plants the potato on the farmland clicked.
+func (p Potato) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeCrop(pos, face, w, Potato{}, user, ctx)
+}
+
+// RandomTick has a chance to make the potato grow a stage.
+func (p Potato) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if p.Growth < 7 && cropGrows(p, pos, w, r) {
+		growCrop(pos, w, p, Potato{Growth: p.Growth + 1})
+	}
+}
+
+// BoneMeal makes the potato grow between two and five stages.
+func (p Potato) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if p.Growth == 7 {
+		return false
+	}
+	return growCrop(pos, w, p, Potato{Growth: boneMealGrowth(p.Growth)})
+}
+
+// NeighbourUpdateTick breaks the potato if the farmland below it is removed.
+func (p Potato) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !cropSupported(pos, w) {
+		breakBlock(p, pos, w)
+	}
+}
+
+// AABB ...
+func (Potato) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (Potato) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (Potato) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (p Potato) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			if p.Growth < 7 {
+				return []item.Stack{item.NewStack(Potato{}, 1)}
+			}
+			drops := []item.Stack{item.NewStack(Potato{}, rand.Intn(5)+1)}
+			if rand.Float64() < 0.02 {
+				drops = append(drops, item.NewStack(item.PoisonousPotato{}, 1))
+			}
+			return drops
+		},
+	}
+}
+
+// EncodeItem ...
+func (Potato) EncodeItem() (id int32, meta int16) {
+	return 392, 0
+}
+
+// EncodeBlock ...
+func (p Potato) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:potatoes", map[string]interface{}{"growth": int32(p.Growth)}
+}
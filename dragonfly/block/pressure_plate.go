@@ -0,0 +1,350 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"time"
+)
+
+// StonePressurePlate is a redstone component that emits power while a mob or player stands on it.
+type StonePressurePlate struct {
+	// Powered specifies if the pressure plate is pressed and emitting power.
+	Powered bool
+}
+
+// WoodPressurePlate is a redstone component that emits power while any entity is on top of it.
+type WoodPressurePlate struct {
+	// Wood is the type of wood of the pressure plate. This field must have one of the values found in the
+	// wood package.
+	Wood wood.Wood
+	// Powered specifies if the pressure plate is pressed and emitting power.
+	Powered bool
+}
+
+// WeightedPressurePlate is a redstone component that emits power depending on the amount of entities on top
+// of it.
+type WeightedPressurePlate struct {
+	// Heavy specifies if the pressure plate is a heavy weighted pressure plate, made of iron. Heavy weighted
+	// pressure plates emit one power level for every ten entities, whereas light weighted pressure plates
+	// emit one power level for every entity.
+	Heavy bool
+	// Power is the power emitted by the pressure plate, from 0-15.
+	Power int
+}
+
+// living represents an entity that has health, such as a player or a mob. It is identical to an
+// entity.Living.
+type living interface {
+	world.Entity
+	Health() float64
+}
+
+// pressurePlateBox is the box in which entities press a pressure plate.
+var pressurePlateBox = physics.NewAABB(mgl64.Vec3{0.0625, 0, 0.0625}, mgl64.Vec3{0.9375, 0.25, 0.9375})
+
+// AABB returns no boxes, as entities are able to walk through pressure plates.
+func (StonePressurePlate) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (p StonePressurePlate) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(StonePressurePlate{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (StonePressurePlate) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// UseOnBlock places the pressure plate on top of the block clicked.
+func (p StonePressurePlate) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placePressurePlate(pos, face, p, w, user, ctx)
+}
+
+// NeighbourUpdateTick removes the pressure plate if the block below it is removed.
+func (p StonePressurePlate) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, world.FaceDown, w) {
+		w.BreakBlock(pos)
+	}
+}
+
+// EntityInside presses the pressure plate if the entity inside of it is a mob or player.
+func (p StonePressurePlate) EntityInside(pos world.BlockPos, w *world.World, e world.Entity) {
+	if _, ok := e.(living); ok && !p.Powered && pressurePlatePressed(pos, w, e) {
+		p.Powered = true
+		setPressed(pos, p, w, time.Second)
+	}
+}
+
+// ScheduledTick releases the pressure plate if no mob or player is on top of it anymore.
+func (p StonePressurePlate) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if !p.Powered {
+		return
+	}
+	for _, e := range pressurePlateEntities(pos, w) {
+		if _, ok := e.(living); ok {
+			w.ScheduleBlockUpdate(pos, time.Second)
+			return
+		}
+	}
+	p.Powered = false
+	setReleased(pos, p, w)
+}
+
+// WeakPower ...
+func (p StonePressurePlate) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	return pressedPower(p.Powered, true)
+}
+
+// StrongPower ...
+func (p StonePressurePlate) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	return pressedPower(p.Powered, face == world.FaceDown)
+}
+
+// EncodeItem ...
+func (StonePressurePlate) EncodeItem() (id int32, meta int16) {
+	return 70, 0
+}
+
+// EncodeBlock ...
+func (p StonePressurePlate) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:stone_pressure_plate", map[string]interface{}{"redstone_signal": int32(pressedPower(p.Powered, true))}
+}
+
+// AABB returns no boxes, as entities are able to walk through pressure plates.
+func (WoodPressurePlate) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (p WoodPressurePlate) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(WoodPressurePlate{Wood: p.Wood}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (WoodPressurePlate) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// UseOnBlock places the pressure plate on top of the block clicked.
+func (p WoodPressurePlate) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placePressurePlate(pos, face, p, w, user, ctx)
+}
+
+// NeighbourUpdateTick removes the pressure plate if the block below it is removed.
+func (p WoodPressurePlate) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, world.FaceDown, w) {
+		w.BreakBlock(pos)
+	}
+}
+
+// EntityInside presses the pressure plate.
+func (p WoodPressurePlate) EntityInside(pos world.BlockPos, w *world.World, e world.Entity) {
+	if !p.Powered && pressurePlatePressed(pos, w, e) {
+		p.Powered = true
+		setPressed(pos, p, w, time.Second)
+	}
+}
+
+// ScheduledTick releases the pressure plate if no entity is on top of it anymore.
+func (p WoodPressurePlate) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if !p.Powered {
+		return
+	}
+	if len(pressurePlateEntities(pos, w)) != 0 {
+		w.ScheduleBlockUpdate(pos, time.Second)
+		return
+	}
+	p.Powered = false
+	setReleased(pos, p, w)
+}
+
+// WeakPower ...
+func (p WoodPressurePlate) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	return pressedPower(p.Powered, true)
+}
+
+// StrongPower ...
+func (p WoodPressurePlate) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	return pressedPower(p.Powered, face == world.FaceDown)
+}
+
+// EncodeItem ...
+func (p WoodPressurePlate) EncodeItem() (id int32, meta int16) {
+	switch p.Wood {
+	case wood.Oak():
+		return 72, 0
+	case wood.Spruce():
+		return -154, 0
+	case wood.Birch():
+		return -151, 0
+	case wood.Jungle():
+		return -153, 0
+	case wood.Acacia():
+		return -150, 0
+	case wood.DarkOak():
+		return -152, 0
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (p WoodPressurePlate) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:" + p.Wood.String() + "_pressure_plate"
+	if p.Wood == wood.Oak() {
+		name = "minecraft:wooden_pressure_plate"
+	}
+	return name, map[string]interface{}{"redstone_signal": int32(pressedPower(p.Powered, true))}
+}
+
+// AABB returns no boxes, as entities are able to walk through pressure plates.
+func (WeightedPressurePlate) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (p WeightedPressurePlate) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.5,
+		BlastResistance: 0.5,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(WeightedPressurePlate{Heavy: p.Heavy}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (WeightedPressurePlate) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// UseOnBlock places the pressure plate on top of the block clicked.
+func (p WeightedPressurePlate) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placePressurePlate(pos, face, p, w, user, ctx)
+}
+
+// NeighbourUpdateTick removes the pressure plate if the block below it is removed.
+func (p WeightedPressurePlate) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, world.FaceDown, w) {
+		w.BreakBlock(pos)
+	}
+}
+
+// EntityInside updates the power of the pressure plate.
+func (p WeightedPressurePlate) EntityInside(pos world.BlockPos, w *world.World, e world.Entity) {
+	if p.Power == 0 && pressurePlatePressed(pos, w, e) {
+		p.ScheduledTick(pos, w)
+	}
+}
+
+// ScheduledTick updates the power of the pressure plate depending on the amount of entities on top of it.
+func (p WeightedPressurePlate) ScheduledTick(pos world.BlockPos, w *world.World) {
+	power := p.weightPower(len(pressurePlateEntities(pos, w)))
+	if power != 0 {
+		w.ScheduleBlockUpdate(pos, time.Second)
+	}
+	if power == p.Power {
+		return
+	}
+	old := p.Power
+	p.Power = power
+	setRedstoneBlock(pos, p, w)
+	if old == 0 {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOn{})
+	} else if power == 0 {
+		w.PlaySound(pos.Vec3Centre(), sound.PowerOff{})
+	}
+}
+
+// weightPower returns the power emitted by the pressure plate with the amount of entities passed on top.
+func (p WeightedPressurePlate) weightPower(entities int) int {
+	power := entities
+	if p.Heavy {
+		power = int(math.Ceil(float64(entities) / 10))
+	}
+	if power > 15 {
+		return 15
+	}
+	return power
+}
+
+// WeakPower ...
+func (p WeightedPressurePlate) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	return p.Power
+}
+
+// StrongPower ...
+func (p WeightedPressurePlate) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	if face == world.FaceDown {
+		return p.Power
+	}
+	return 0
+}
+
+// EncodeItem ...
+func (p WeightedPressurePlate) EncodeItem() (id int32, meta int16) {
+	if p.Heavy {
+		return 148, 0
+	}
+	return 147, 0
+}
+
+// EncodeBlock ...
+func (p WeightedPressurePlate) EncodeBlock() (name string, properties map[string]interface{}) {
+	if p.Heavy {
+		return "minecraft:heavy_weighted_pressure_plate", map[string]interface{}{"redstone_signal": int32(p.Power)}
+	}
+	return "minecraft:light_weighted_pressure_plate", map[string]interface{}{"redstone_signal": int32(p.Power)}
+}
+
+// placePressurePlate places the pressure plate passed on top of the block clicked, if that block is solid.
+func placePressurePlate(pos world.BlockPos, face world.Face, p world.Block, w *world.World, user item.User, ctx *item.UseContext) bool {
+	pos, _, used := firstReplaceable(w, pos, face, p)
+	if !used || !redstoneSupported(pos, world.FaceDown, w) {
+		return false
+	}
+	place(w, pos, p, user, ctx)
+	return placed(ctx)
+}
+
+// pressurePlatePressed checks if the entity passed is on top of the pressure plate at the position passed.
+func pressurePlatePressed(pos world.BlockPos, w *world.World, e world.Entity) bool {
+	return e.AABB().Translate(e.Position()).IntersectsWith(pressurePlateBox.Translate(pos.Vec3()))
+}
+
+// pressurePlateEntities returns all entities currently on top of the pressure plate at the position passed.
+func pressurePlateEntities(pos world.BlockPos, w *world.World) []world.Entity {
+	return w.EntitiesWithin(pressurePlateBox.Translate(pos.Vec3()))
+}
+
+// allPressurePlates returns all possible states of pressure plates.
+func allPressurePlates() (plates []world.Block) {
+	for _, powered := range []bool{false, true} {
+		plates = append(plates, StonePressurePlate{Powered: powered})
+		for _, w := range wood.All() {
+			plates = append(plates, WoodPressurePlate{Wood: w, Powered: powered})
+		}
+	}
+	for i := 0; i < 16; i++ {
+		plates = append(plates, WeightedPressurePlate{Power: i}, WeightedPressurePlate{Heavy: true, Power: i})
+	}
+	return
+}
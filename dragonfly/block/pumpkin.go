@@ -0,0 +1,53 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Pumpkin is a crop block grown by pumpkin stems. Pumpkins may be placed with their face facing the user.
+type Pumpkin struct {
+	// Facing is the direction that the face of the pumpkin faces.
+	Facing world.Direction
+}
+
+// UseOnBlock places the pumpkin with its face facing the user.
+func (p Pumpkin) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, p)
+	if !used {
+		return
+	}
+	p.Facing = user.Facing().Opposite()
+	place(w, pos, p, user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (p Pumpkin) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        1,
+		BlastResistance: 1,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(Pumpkin{}, 1)),
+	}
+}
+
+// EncodeItem ...
+func (Pumpkin) EncodeItem() (id int32, meta int16) {
+	return 86, 0
+}
+
+// EncodeBlock ...
+func (p Pumpkin) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:pumpkin", map[string]interface{}{"direction": horizontalDirection(p.Facing)}
+}
+
+// allPumpkins returns all possible states of pumpkins.
+func allPumpkins() (pumpkins []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		pumpkins = append(pumpkins, Pumpkin{Facing: d})
+	}
+	return
+}
@@ -0,0 +1,110 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// PumpkinSeeds are a crop that grows into a pumpkin stem. A fully grown pumpkin stem grows pumpkins on the
+// blocks next to it.
+type PumpkinSeeds struct {
+	// Growth is the stage of growth of the stem, from 0-7. A stem with a growth stage of 7 is fully grown and
+	// grows pumpkins next to it.
+	Growth int
+	// Attached specifies if the stem is attached to a pumpkin that it grew.
+	Attached bool
+	// Facing is the direction of the pumpkin that the stem is attached to. It is only used if Attached is
+	// true.
+	Facing world.Direction
+}
+
+// GrowthStage ...
+func (p PumpkinSeeds) GrowthStage() int {
+	return p.Growth
+}
+
+// UseOnBlock plants the pumpkin seeds on the farmland clicked.
+func (p PumpkinSeeds) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeCrop(pos, face, w, PumpkinSeeds{}, user, ctx)
+}
+
+// RandomTick has a chance to make the stem grow a stage, or to grow a pumpkin next to it if the stem is fully
+// grown.
+func (p PumpkinSeeds) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if p.Attached || !cropGrows(p, pos, w, r) {
+		return
+	}
+	if p.Growth < 7 {
+		growCrop(pos, w, p, PumpkinSeeds{Growth: p.Growth + 1})
+		return
+	}
+	if d, ok := growStemFruit(pos, w, r, Pumpkin{Facing: world.Direction(r.Intn(4))}); ok {
+		p.Attached, p.Facing = true, d
+		w.SetBlock(pos, p)
+	}
+}
+
+// BoneMeal makes the stem grow between two and five stages.
+func (p PumpkinSeeds) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if p.Growth == 7 {
+		return false
+	}
+	return growCrop(pos, w, p, PumpkinSeeds{Growth: boneMealGrowth(p.Growth)})
+}
+
+// NeighbourUpdateTick breaks the stem if the farmland below it is removed and detaches it if the pumpkin it
+// is attached to is removed.
+func (p PumpkinSeeds) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !cropSupported(pos, w) {
+		breakBlock(p, pos, w)
+		return
+	}
+	if _, ok := w.Block(pos.Side(p.Facing.Face())).(Pumpkin); p.Attached && !ok {
+		w.SetBlock(pos, PumpkinSeeds{Growth: p.Growth})
+	}
+}
+
+// AABB ...
+func (PumpkinSeeds) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (PumpkinSeeds) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (PumpkinSeeds) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (p PumpkinSeeds) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			if n := stemDrops(p.Growth); n > 0 {
+				return []item.Stack{item.NewStack(PumpkinSeeds{}, n)}
+			}
+			return nil
+		},
+	}
+}
+
+// EncodeItem ...
+func (PumpkinSeeds) EncodeItem() (id int32, meta int16) {
+	return 361, 0
+}
+
+// EncodeBlock ...
+func (p PumpkinSeeds) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:pumpkin_stem", stemProperties(p.Growth, p.Attached, p.Facing)
+}
@@ -0,0 +1,63 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+)
+
+// horizontalDirection converts a direction to the value used in the 'direction' property of blocks such as
+// repeaters, doors and anvils.
+func horizontalDirection(d world.Direction) int32 {
+	switch d {
+	case world.South:
+		return 0
+	case world.West:
+		return 1
+	case world.North:
+		return 2
+	case world.East:
+		return 3
+	}
+	panic("invalid direction")
+}
+
+// attachedFaceDirection converts a face that a block such as a torch or a lever is attached to into the
+// name of the direction that the block is pointing in.
+func attachedFaceDirection(f world.Face) string {
+	switch f {
+	case world.FaceNorth:
+		return "south"
+	case world.FaceSouth:
+		return "north"
+	case world.FaceWest:
+		return "east"
+	case world.FaceEast:
+		return "west"
+	}
+	panic("invalid attached face")
+}
+
+// setRedstoneBlock sets the redstone component passed to the position passed and updates the blocks around
+// it so that they react to the change in power.
+func setRedstoneBlock(pos world.BlockPos, b world.Block, w *world.World) {
+	w.SetBlock(pos, b)
+	w.UpdateRedstone(pos)
+}
+
+// redstoneSupported checks if a redstone component at the position passed is supported by the block on the
+// face passed. Redstone components may only be attached to solid blocks.
+func redstoneSupported(pos world.BlockPos, face world.Face, w *world.World) bool {
+	return w.RedstoneConductor(pos.Side(face))
+}
+
+// redstoneChanged checks if the change of the block at the position passed could have changed the redstone
+// power around it. This is the case if the block is a redstone component or a block that could carry redstone
+// power, or if the block is now air, as a redstone component, such as a lever, may have been removed there.
+// Blocks that may be opened both by hand and by redstone, such as doors, only react to neighbour updates from
+// such changes, so that they are not closed by unrelated changes around them.
+func redstoneChanged(pos world.BlockPos, w *world.World) bool {
+	switch w.Block(pos).(type) {
+	case world.RedstoneEmitter, Air:
+		return true
+	}
+	return w.RedstoneConductor(pos)
+}
@@ -0,0 +1,40 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+)
+
+// RedstoneBlock is a block crafted from 9 redstone dust. It is a permanent source of redstone power.
+type RedstoneBlock struct{}
+
+// BreakInfo ...
+func (r RedstoneBlock) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        5,
+		BlastResistance: 6,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(r, 1)),
+	}
+}
+
+// WeakPower ...
+func (RedstoneBlock) WeakPower(world.BlockPos, world.Face, *world.World) int {
+	return 15
+}
+
+// StrongPower always returns 0: Blocks of redstone do not power the blocks around them through solid blocks.
+func (RedstoneBlock) StrongPower(world.BlockPos, world.Face, *world.World) int {
+	return 0
+}
+
+// EncodeItem ...
+func (RedstoneBlock) EncodeItem() (id int32, meta int16) {
+	return 152, 0
+}
+
+// EncodeBlock ...
+func (RedstoneBlock) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:redstone_block", nil
+}
@@ -0,0 +1,175 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// RedstoneDust is a block that carries redstone power over a distance. The power carried by the dust
+// decreases by one for every block that it travels, so that dust carries power for up to 15 blocks.
+type RedstoneDust struct {
+	// Power is the redstone power carried by the dust, from 0-15.
+	Power int
+}
+
+// AABB returns no boxes, as entities are able to walk through redstone dust.
+func (RedstoneDust) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (r RedstoneDust) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(RedstoneDust{}, 1)),
+	}
+}
+
+// HasLiquidDrops ...
+func (RedstoneDust) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (RedstoneDust) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// UseOnBlock places redstone dust on top of the block clicked, if that block is solid.
+func (r RedstoneDust) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, r)
+	if !used || !redstoneSupported(pos, world.FaceDown, w) {
+		return false
+	}
+	place(w, pos, RedstoneDust{}, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick recalculates the power carried by the dust and removes the dust if the block below it
+// is no longer solid.
+func (r RedstoneDust) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, world.FaceDown, w) {
+		w.BreakBlock(pos)
+		return
+	}
+	if power := r.receivedPower(pos, w); power != r.Power {
+		r.Power = power
+		setRedstoneBlock(pos, r, w)
+	}
+}
+
+// WirePower ...
+func (r RedstoneDust) WirePower() int {
+	return r.Power
+}
+
+// WeakPower ...
+func (r RedstoneDust) WeakPower(pos world.BlockPos, face world.Face, w *world.World) int {
+	switch {
+	case r.Power == 0 || face == world.FaceUp:
+		return 0
+	case face == world.FaceDown || r.pointsTowards(pos, world.Direction(face-2), w):
+		return r.Power
+	}
+	return 0
+}
+
+// StrongPower ...
+func (r RedstoneDust) StrongPower(pos world.BlockPos, face world.Face, w *world.World) int {
+	return r.WeakPower(pos, face, w)
+}
+
+// receivedPower returns the power that the dust at the position passed should carry. The power is either the
+// power received from the blocks around it, or the power of the dust connected to it minus one.
+func (r RedstoneDust) receivedPower(pos world.BlockPos, w *world.World) int {
+	power := w.ReceivedRedstonePower(pos)
+	solidAbove := w.RedstoneConductor(pos.Side(world.FaceUp))
+	for d := world.North; d <= world.East; d++ {
+		side := pos.Side(d.Face())
+		power = maxDustPower(power, side, w)
+		if !w.RedstoneConductor(side) {
+			power = maxDustPower(power, side.Side(world.FaceDown), w)
+		} else if !solidAbove {
+			power = maxDustPower(power, side.Side(world.FaceUp), w)
+		}
+	}
+	return power
+}
+
+// maxDustPower returns the highest of the power passed and the power of the dust at the position passed
+// minus one.
+func maxDustPower(power int, pos world.BlockPos, w *world.World) int {
+	if dust, ok := w.Block(pos).(RedstoneDust); ok && dust.Power-1 > power {
+		return dust.Power - 1
+	}
+	return power
+}
+
+// pointsTowards checks if the dust at the position passed points towards the direction passed. Dust that
+// does not connect to anything points in all directions, dust that connects to one side points towards that
+// side and the opposite side, and otherwise the dust points towards the sides it connects to.
+func (r RedstoneDust) pointsTowards(pos world.BlockPos, d world.Direction, w *world.World) bool {
+	var connected [4]bool
+	n := 0
+	for dir := world.North; dir <= world.East; dir++ {
+		if r.connectsTo(pos, dir, w) {
+			connected[dir] = true
+			n++
+		}
+	}
+	switch n {
+	case 0:
+		return true
+	case 1:
+		return connected[d] || connected[d.Opposite()]
+	}
+	return connected[d]
+}
+
+// connectsTo checks if the dust at the position passed connects to the block in the direction passed.
+func (r RedstoneDust) connectsTo(pos world.BlockPos, d world.Direction, w *world.World) bool {
+	side := pos.Side(d.Face())
+	switch b := w.Block(side).(type) {
+	case RedstoneDust:
+		return true
+	case Repeater:
+		return b.Facing == d || b.Facing == d.Opposite()
+	case Comparator:
+		return b.Facing == d || b.Facing == d.Opposite()
+	case world.RedstoneEmitter:
+		return true
+	}
+	if !w.RedstoneConductor(side) {
+		_, ok := w.Block(side.Side(world.FaceDown)).(RedstoneDust)
+		return ok
+	}
+	if !w.RedstoneConductor(pos.Side(world.FaceUp)) {
+		_, ok := w.Block(side.Side(world.FaceUp)).(RedstoneDust)
+		return ok
+	}
+	return false
+}
+
+// EncodeItem ...
+func (RedstoneDust) EncodeItem() (id int32, meta int16) {
+	return 331, 0
+}
+
+// EncodeBlock ...
+func (r RedstoneDust) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:redstone_wire", map[string]interface{}{"redstone_signal": int32(r.Power)}
+}
+
+// allRedstoneDust returns redstone dust with all possible power levels.
+func allRedstoneDust() []world.Block {
+	b := make([]world.Block, 0, 16)
+	for i := 0; i < 16; i++ {
+		b = append(b, RedstoneDust{Power: i})
+	}
+	return b
+}
@@ -0,0 +1,77 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// RedstoneLamp is a block that emits light while it is powered by redstone.
+type RedstoneLamp struct {
+	// Lit specifies if the redstone lamp is lit and emitting light.
+	Lit bool
+}
+
+// BreakInfo ...
+func (l RedstoneLamp) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0.3,
+		BlastResistance: 0.3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(RedstoneLamp{}, 1)),
+	}
+}
+
+// LightEmissionLevel ...
+func (l RedstoneLamp) LightEmissionLevel() uint8 {
+	if l.Lit {
+		return 15
+	}
+	return 0
+}
+
+// UseOnBlock places the redstone lamp, lighting it immediately if it is placed next to a source of power.
+func (l RedstoneLamp) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, l)
+	if !used {
+		return
+	}
+	l.Lit = w.ReceivedRedstonePower(pos) > 0
+	place(w, pos, l, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick lights the lamp as soon as it is powered. Lamps that are no longer powered turn off
+// after a short delay.
+func (l RedstoneLamp) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	powered := w.ReceivedRedstonePower(pos) > 0
+	if powered && !l.Lit {
+		l.Lit = true
+		w.SetBlock(pos, l)
+	} else if !powered && l.Lit {
+		w.ScheduleBlockUpdate(pos, time.Second/5)
+	}
+}
+
+// ScheduledTick turns the lamp off if it is no longer powered.
+func (l RedstoneLamp) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if l.Lit && w.ReceivedRedstonePower(pos) == 0 {
+		l.Lit = false
+		w.SetBlock(pos, l)
+	}
+}
+
+// EncodeItem ...
+func (RedstoneLamp) EncodeItem() (id int32, meta int16) {
+	return 123, 0
+}
+
+// EncodeBlock ...
+func (l RedstoneLamp) EncodeBlock() (name string, properties map[string]interface{}) {
+	if l.Lit {
+		return "minecraft:lit_redstone_lamp", nil
+	}
+	return "minecraft:redstone_lamp", nil
+}
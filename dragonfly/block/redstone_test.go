@@ -0,0 +1,300 @@
+package block_test
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	blockAction "github.com/df-mc/dragonfly/dragonfly/block/action"
+	_ "github.com/df-mc/dragonfly/dragonfly/entity" // Imported so that the entities created by blocks are linked.
+	"github.com/df-mc/dragonfly/dragonfly/entity/action"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/chunk"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+)
+
+// blockUpdate is a block update viewed by a testViewer.
+type blockUpdate struct {
+	pos world.BlockPos
+	b   world.Block
+}
+
+// testViewer is a world.Viewer that records the block updates that it views. A world only ticks while it
+// has viewers, so a testViewer is added to every world created using newRedstoneWorld.
+type testViewer struct {
+	mu      sync.Mutex
+	updates []blockUpdate
+}
+
+// reset clears the block updates recorded by the viewer.
+func (v *testViewer) reset() {
+	v.mu.Lock()
+	v.updates = nil
+	v.mu.Unlock()
+}
+
+// recorded returns the block updates recorded by the viewer.
+func (v *testViewer) recorded() []blockUpdate {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return append([]blockUpdate(nil), v.updates...)
+}
+
+func (v *testViewer) ViewBlockUpdate(pos world.BlockPos, b world.Block, _ int) {
+	v.mu.Lock()
+	v.updates = append(v.updates, blockUpdate{pos: pos, b: b})
+	v.mu.Unlock()
+}
+
+func (*testViewer) Position() mgl64.Vec3                                                   { return mgl64.Vec3{} }
+func (*testViewer) ViewEntity(world.Entity)                                                {}
+func (*testViewer) HideEntity(world.Entity)                                                {}
+func (*testViewer) ViewEntityMovement(world.Entity, mgl64.Vec3, float64, float64)          {}
+func (*testViewer) ViewEntityTeleport(world.Entity, mgl64.Vec3)                            {}
+func (*testViewer) ViewChunk(world.ChunkPos, *chunk.Chunk, map[world.BlockPos]world.Block) {}
+func (*testViewer) ViewTime(int)                                                           {}
+func (*testViewer) ViewWeather(bool, bool)                                                 {}
+func (*testViewer) ViewGameRule(gamerule.GameRule, interface{})                            {}
+func (*testViewer) ViewEntityItems(world.Entity)                                           {}
+func (*testViewer) ViewEntityArmour(world.Entity)                                          {}
+func (*testViewer) ViewEntityAction(world.Entity, action.Action)                           {}
+func (*testViewer) ViewEntityState(world.Entity, []state.State)                            {}
+func (*testViewer) ViewParticle(mgl64.Vec3, world.Particle)                                {}
+func (*testViewer) ViewSound(mgl64.Vec3, world.Sound)                                      {}
+func (*testViewer) ViewBlockAction(world.BlockPos, blockAction.Action)                     {}
+func (*testViewer) ViewEmote(world.Entity, uuid.UUID)                                      {}
+
+// newRedstoneWorld creates a new in-memory world with a floor of stone at y=0 around the origin. The world
+// has a testViewer, which is returned, so that it ticks. The function returned closes the world.
+func newRedstoneWorld(t *testing.T) (*world.World, *testViewer, func()) {
+	log := logrus.New()
+	log.Out = ioutil.Discard
+	w := world.New(log, 4)
+	v := &testViewer{}
+	l := world.NewLoader(2, w, v)
+	if err := l.Load(25); err != nil {
+		t.Fatalf("error loading chunks: %v", err)
+	}
+	for x := -8; x <= 24; x++ {
+		for z := -8; z <= 8; z++ {
+			w.SetBlock(world.BlockPos{x, 0, z}, block.Stone{})
+		}
+	}
+	return w, v, func() {
+		_ = l.Close()
+		_ = w.Close()
+	}
+}
+
+// waitFor waits until the condition passed is true. If it does not become true within a couple of seconds,
+// the test is failed with the message passed.
+func waitFor(t *testing.T, cond func() bool, msg string) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 5)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal(msg)
+		}
+		time.Sleep(time.Millisecond * 2)
+	}
+}
+
+// waitTicks waits until the world passed has ticked at least n times.
+func waitTicks(t *testing.T, w *world.World, n int64) {
+	t.Helper()
+	target := w.CurrentTick() + n
+	waitFor(t, func() bool { return w.CurrentTick() >= target }, "world stopped ticking")
+}
+
+func TestRedstoneDustDecay(t *testing.T) {
+	w, _, closeWorld := newRedstoneWorld(t)
+	defer closeWorld()
+
+	for x := 1; x <= 17; x++ {
+		w.PlaceBlock(world.BlockPos{x, 1, 0}, block.RedstoneDust{})
+	}
+	w.PlaceBlock(world.BlockPos{0, 1, 0}, block.RedstoneBlock{})
+	for x := 1; x <= 17; x++ {
+		expected := 16 - x
+		if expected < 0 {
+			expected = 0
+		}
+		if dust := w.Block(world.BlockPos{x, 1, 0}).(block.RedstoneDust); dust.Power != expected {
+			t.Errorf("dust at x=%v: expected power %v, got %v", x, expected, dust.Power)
+		}
+	}
+
+	w.BreakBlock(world.BlockPos{0, 1, 0})
+	for x := 1; x <= 17; x++ {
+		if dust := w.Block(world.BlockPos{x, 1, 0}).(block.RedstoneDust); dust.Power != 0 {
+			t.Errorf("dust at x=%v: expected no power after removing the source, got %v", x, dust.Power)
+		}
+	}
+}
+
+func TestRedstoneTorchBurnout(t *testing.T) {
+	// burnoutToggles is the amount of times a redstone torch may turn off within three seconds before it burns
+	// out.
+	const burnoutToggles = 8
+
+	w, _, closeWorld := newRedstoneWorld(t)
+	defer closeWorld()
+
+	base, torchPos, leverPos := world.BlockPos{0, 1, 0}, world.BlockPos{1, 1, 0}, world.BlockPos{0, 2, 0}
+	w.SetBlock(base, block.Stone{})
+	w.PlaceBlock(torchPos, block.RedstoneTorch{Facing: world.FaceWest, Lit: true})
+	w.PlaceBlock(leverPos, block.Lever{Facing: world.FaceDown, Axis: world.X})
+
+	lit := func() bool { return w.Block(torchPos).(block.RedstoneTorch).Lit }
+	toggleLever := func() {
+		w.Block(leverPos).(block.Lever).Activate(leverPos, world.FaceUp, w, nil)
+	}
+	for i := 1; i < burnoutToggles; i++ {
+		toggleLever()
+		waitFor(t, func() bool { return !lit() }, "torch did not turn off while its block was powered")
+		toggleLever()
+		waitFor(t, lit, "torch did not turn on again before burning out")
+	}
+	toggleLever()
+	waitFor(t, func() bool { return !lit() }, "torch did not turn off while its block was powered")
+	if n := w.RedstoneToggles(torchPos); n < burnoutToggles {
+		t.Fatalf("expected at least %v toggles to be recorded, got %v", burnoutToggles, n)
+	}
+	toggleLever()
+	waitTicks(t, w, 10)
+	if lit() {
+		t.Fatal("burnt out torch turned on again right away")
+	}
+
+	// The toggles of the torch must be forgotten once the burnout period passed, so that the world does not
+	// hold on to them forever.
+	waitFor(t, func() bool { return w.RedstoneToggles(torchPos) == 0 }, "torch toggles were not pruned")
+}
+
+func TestRepeaterDelay(t *testing.T) {
+	w, _, closeWorld := newRedstoneWorld(t)
+	defer closeWorld()
+
+	for delay := 0; delay < 4; delay++ {
+		pos := world.BlockPos{2, 1, delay * 2}
+		w.PlaceBlock(pos, block.Repeater{Facing: world.East, Delay: delay})
+
+		start := w.CurrentTick()
+		w.PlaceBlock(pos.Side(world.FaceWest), block.RedstoneBlock{})
+		if w.Block(pos).(block.Repeater).Powered {
+			t.Fatalf("repeater with delay %v was powered without delay", delay)
+		}
+		waitFor(t, func() bool { return w.Block(pos).(block.Repeater).Powered }, "repeater was never powered")
+		if ticks, expected := w.CurrentTick()-start, int64(delay+1)*2; ticks < expected {
+			t.Errorf("repeater with delay %v was powered after %v ticks, expected %v", delay, ticks, expected)
+		}
+	}
+}
+
+func TestRepeaterLocking(t *testing.T) {
+	w, _, closeWorld := newRedstoneWorld(t)
+	defer closeWorld()
+
+	pos := world.BlockPos{2, 1, 0}
+	lockPos := pos.Side(world.FaceSouth)
+	w.PlaceBlock(pos, block.Repeater{Facing: world.East})
+	w.PlaceBlock(lockPos, block.Repeater{Facing: world.North})
+
+	w.PlaceBlock(lockPos.Side(world.FaceSouth), block.RedstoneBlock{})
+	waitFor(t, func() bool { return w.Block(lockPos).(block.Repeater).Powered }, "locking repeater was never powered")
+	if !w.Block(pos).(block.Repeater).Locked(pos, w) {
+		t.Fatal("repeater was not locked by the powered repeater facing into its side")
+	}
+
+	w.PlaceBlock(pos.Side(world.FaceWest), block.RedstoneBlock{})
+	waitTicks(t, w, 10)
+	if w.Block(pos).(block.Repeater).Powered {
+		t.Fatal("locked repeater changed its output")
+	}
+
+	w.BreakBlock(lockPos.Side(world.FaceSouth))
+	waitFor(t, func() bool { return w.Block(pos).(block.Repeater).Powered }, "repeater was not powered after being unlocked")
+}
+
+func TestComparatorModes(t *testing.T) {
+	w, _, closeWorld := newRedstoneWorld(t)
+	defer closeWorld()
+
+	pos := world.BlockPos{4, 1, 0}
+	w.PlaceBlock(pos, block.Comparator{Facing: world.East})
+	// The comparator receives 13 power from the dust on its north side: The dust is powered by a block of
+	// redstone three blocks further.
+	side := pos.Side(world.FaceNorth)
+	for i := 0; i < 3; i++ {
+		w.PlaceBlock(side, block.RedstoneDust{})
+		side = side.Side(world.FaceNorth)
+	}
+	w.PlaceBlock(side, block.RedstoneBlock{})
+	w.PlaceBlock(pos.Side(world.FaceWest), block.RedstoneBlock{})
+
+	output := func() int { return w.Block(pos).(block.Comparator).Power }
+	activate := func() {
+		w.Block(pos).(block.Comparator).Activate(pos, world.FaceUp, w, nil)
+	}
+	waitFor(t, func() bool { return output() == 15 }, "comparator did not output its rear power in comparison mode")
+
+	activate()
+	waitFor(t, func() bool { return output() == 2 }, "comparator did not subtract its side power in subtraction mode")
+
+	w.PlaceBlock(pos.Side(world.FaceSouth), block.RedstoneBlock{})
+	waitFor(t, func() bool { return output() == 0 }, "comparator did not subtract the highest side power")
+
+	activate()
+	waitFor(t, func() bool { return output() == 15 }, "comparator did not output its rear power for equal side power")
+}
+
+func TestRedstoneUpdateOrder(t *testing.T) {
+	// run builds the same contraption in a new world and powers it, returning all block updates that the
+	// contraption caused.
+	run := func() []blockUpdate {
+		w, v, closeWorld := newRedstoneWorld(t)
+		defer closeWorld()
+
+		w.PlaceBlock(world.BlockPos{0, 1, 0}, block.Repeater{Facing: world.East, Delay: 1})
+		for x := 1; x <= 6; x++ {
+			w.PlaceBlock(world.BlockPos{x, 1, 0}, block.RedstoneDust{})
+			w.PlaceBlock(world.BlockPos{x, 1, 1}, block.RedstoneLamp{})
+		}
+		w.SetBlock(world.BlockPos{7, 1, 0}, block.Stone{})
+		w.PlaceBlock(world.BlockPos{8, 1, 0}, block.RedstoneTorch{Facing: world.FaceWest, Lit: true})
+		w.PlaceBlock(world.BlockPos{9, 1, 0}, block.Repeater{Facing: world.East})
+		w.PlaceBlock(world.BlockPos{10, 1, 0}, block.Comparator{Facing: world.East})
+		w.PlaceBlock(world.BlockPos{11, 1, 0}, block.RedstoneLamp{})
+		w.PlaceBlock(world.BlockPos{11, 1, 1}, block.RedstoneDust{})
+		waitTicks(t, w, 20)
+
+		// The block of redstone only schedules an update of the repeater, so all other updates happen while
+		// the world ticks.
+		v.reset()
+		w.PlaceBlock(world.BlockPos{-1, 1, 0}, block.RedstoneBlock{})
+		waitFor(t, func() bool {
+			lamp := w.Block(world.BlockPos{11, 1, 0}).(block.RedstoneLamp)
+			return !lamp.Lit
+		}, "contraption was never fully powered")
+		waitTicks(t, w, 20)
+		return v.recorded()
+	}
+
+	first, second := run(), run()
+	if len(first) == 0 {
+		t.Fatal("no block updates recorded")
+	}
+	if len(first) != len(second) {
+		t.Fatalf("contraption caused %v block updates the first time and %v the second time", len(first), len(second))
+	}
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("block update %v differs: %+v != %+v", i, first[i], second[i])
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// RedstoneTorch is a redstone component that emits power while the block that it is attached to is not
+// powered. Redstone torches that are toggled too often in a short period of time burn out for a while.
+type RedstoneTorch struct {
+	// Facing is the face of the block that the torch is attached to. world.FaceDown means the torch is standing
+	// on top of a block.
+	Facing world.Face
+	// Lit specifies if the torch is lit and emitting power.
+	Lit bool
+}
+
+// torchBurnoutPeriod is the period during which a redstone torch may be toggled at most torchBurnoutToggles
+// times before it burns out.
+const (
+	torchBurnoutPeriod  = time.Second * 3
+	torchBurnoutToggles = 8
+)
+
+// AABB returns no boxes, as entities are able to walk through redstone torches.
+func (RedstoneTorch) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (t RedstoneTorch) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(RedstoneTorch{Lit: true}, 1)),
+	}
+}
+
+// LightEmissionLevel ...
+func (t RedstoneTorch) LightEmissionLevel() uint8 {
+	if t.Lit {
+		return 7
+	}
+	return 0
+}
+
+// LightDiffusionLevel ...
+func (RedstoneTorch) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// HasLiquidDrops ...
+func (RedstoneTorch) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock attaches the redstone torch to the face of the block clicked. Torches cannot be attached to the
+// bottom of a block.
+func (t RedstoneTorch) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, t)
+	if !used || face == world.FaceDown {
+		return false
+	}
+	t.Facing = face.Opposite()
+	if !redstoneSupported(pos, t.Facing, w) {
+		return false
+	}
+	t.Lit = true
+	place(w, pos, t, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick removes the torch if the block it is attached to is removed and schedules an update
+// if the torch should be toggled.
+func (t RedstoneTorch) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, t.Facing, w) {
+		w.BreakBlock(pos)
+		return
+	}
+	if t.Lit != t.shouldBeLit(pos, w) {
+		w.ScheduleBlockUpdate(pos, time.Second/10)
+	}
+}
+
+// ScheduledTick toggles the torch if the power of the block it is attached to changed. If the torch turns
+// off too often within a short period of time, it burns out and stays off for eight seconds.
+func (t RedstoneTorch) ScheduledTick(pos world.BlockPos, w *world.World) {
+	lit := t.shouldBeLit(pos, w)
+	if t.Lit && !lit {
+		t.Lit = false
+		setRedstoneBlock(pos, t, w)
+		if w.ToggleRedstone(pos, torchBurnoutPeriod) >= torchBurnoutToggles {
+			w.PlaySound(pos.Vec3Centre(), sound.Fizz{})
+			w.ScheduleBlockUpdate(pos, time.Second*8)
+		}
+	} else if !t.Lit && lit {
+		if w.RedstoneToggles(pos) >= torchBurnoutToggles {
+			w.ScheduleBlockUpdate(pos, time.Second*8)
+			return
+		}
+		t.Lit = true
+		setRedstoneBlock(pos, t, w)
+	}
+}
+
+// shouldBeLit checks if the torch should be lit, which is the case if the block it is attached to is not
+// powered.
+func (t RedstoneTorch) shouldBeLit(pos world.BlockPos, w *world.World) bool {
+	return w.RedstonePower(pos, t.Facing) == 0
+}
+
+// WeakPower ...
+func (t RedstoneTorch) WeakPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	if t.Lit && face != t.Facing {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (t RedstoneTorch) StrongPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	if t.Lit && face == world.FaceUp {
+		return 15
+	}
+	return 0
+}
+
+// EncodeItem ...
+func (RedstoneTorch) EncodeItem() (id int32, meta int16) {
+	return 76, 0
+}
+
+// EncodeBlock ...
+func (t RedstoneTorch) EncodeBlock() (name string, properties map[string]interface{}) {
+	direction := "top"
+	if t.Facing != world.FaceDown {
+		direction = attachedFaceDirection(t.Facing)
+	}
+	if t.Lit {
+		return "minecraft:redstone_torch", map[string]interface{}{"torch_facing_direction": direction}
+	}
+	return "minecraft:unlit_redstone_torch", map[string]interface{}{"torch_facing_direction": direction}
+}
+
+// allRedstoneTorches returns all possible states of redstone torches.
+func allRedstoneTorches() (torches []world.Block) {
+	for _, f := range []world.Face{world.FaceDown, world.FaceNorth, world.FaceSouth, world.FaceWest, world.FaceEast} {
+		torches = append(torches, RedstoneTorch{Facing: f, Lit: true}, RedstoneTorch{Facing: f})
+	}
+	return
+}
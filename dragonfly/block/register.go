@@ -37,6 +37,8 @@ func init() {
 	world.RegisterBlock(EmeraldBlock{})
 	world.RegisterBlock(GoldBlock{})
 	world.RegisterBlock(IronBlock{})
+	world.RegisterBlock(GoldOre{})
+	world.RegisterBlock(IronOre{})
 	world.RegisterBlock(Beacon{})
 	world.RegisterBlock(Sponge{})
 	world.RegisterBlock(Sponge{Wet: true})
@@ -45,6 +47,35 @@ func init() {
 	world.RegisterBlock(Terracotta{})
 	world.RegisterBlock(allCarpets()...)
 	world.RegisterBlock(allWool()...)
+	world.RegisterBlock(CraftingTable{})
+	world.RegisterBlock(allFire()...)
+	world.RegisterBlock(TNT{})
+	world.RegisterBlock(allRedstoneDust()...)
+	world.RegisterBlock(allRedstoneTorches()...)
+	world.RegisterBlock(allLevers()...)
+	world.RegisterBlock(allButtons()...)
+	world.RegisterBlock(allPressurePlates()...)
+	world.RegisterBlock(allRepeaters()...)
+	world.RegisterBlock(allComparators()...)
+	world.RegisterBlock(RedstoneLamp{}, RedstoneLamp{Lit: true})
+	world.RegisterBlock(RedstoneBlock{})
+	world.RegisterBlock(allDoors()...)
+	world.RegisterBlock(allTrapdoors()...)
+	world.RegisterBlock(allFenceGates()...)
+	world.RegisterBlock(Sand{}, Sand{Red: true})
+	world.RegisterBlock(Gravel{})
+	world.RegisterBlock(allConcretePowder()...)
+	world.RegisterBlock(allAnvils()...)
+	world.RegisterBlock(allFarmland()...)
+	world.RegisterBlock(allCrops()...)
+	world.RegisterBlock(allPumpkins()...)
+	world.RegisterBlock(Melon{})
+	world.RegisterBlock(allSaplings()...)
+	world.RegisterBlock(allFurnaces()...)
+	world.RegisterBlock(allBlastFurnaces()...)
+	world.RegisterBlock(allSmokers()...)
+	world.RegisterBlock(allSigns()...)
+	world.RegisterBlock(allBeds()...)
 }
 
 func init() {
@@ -66,14 +97,14 @@ func init() {
 	world.RegisterItem("minecraft:log", Log{Wood: wood.Spruce()})
 	world.RegisterItem("minecraft:log", Log{Wood: wood.Birch()})
 	world.RegisterItem("minecraft:log", Log{Wood: wood.Jungle()})
-	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Oak()})
-	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Spruce()})
-	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Birch()})
-	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Jungle()})
+	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Oak(), Persistent: true})
+	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Spruce(), Persistent: true})
+	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Birch(), Persistent: true})
+	world.RegisterItem("minecraft:leaves", Leaves{Wood: wood.Jungle(), Persistent: true})
 	world.RegisterItem("minecraft:chest", Chest{})
 	world.RegisterItem("minecraft:mossy_cobblestone", Cobblestone{Mossy: true})
-	world.RegisterItem("minecraft:leaves2", Leaves{Wood: wood.Acacia()})
-	world.RegisterItem("minecraft:leaves2", Leaves{Wood: wood.DarkOak()})
+	world.RegisterItem("minecraft:leaves2", Leaves{Wood: wood.Acacia(), Persistent: true})
+	world.RegisterItem("minecraft:leaves2", Leaves{Wood: wood.DarkOak(), Persistent: true})
 	world.RegisterItem("minecraft:log2", Log{Wood: wood.Acacia()})
 	world.RegisterItem("minecraft:log2", Log{Wood: wood.DarkOak()})
 	world.RegisterItem("minecraft:stripped_spruce_log", Log{Wood: wood.Spruce(), Stripped: true})
@@ -84,6 +115,7 @@ func init() {
 	world.RegisterItem("minecraft:stripped_oak_log", Log{Wood: wood.Oak(), Stripped: true})
 	for _, c := range colour.All() {
 		world.RegisterItem("minecraft:concrete", Concrete{Colour: c})
+		world.RegisterItem("minecraft:concretePowder", ConcretePowder{Colour: c})
 		world.RegisterItem("minecraft:stained_hardened_clay", StainedTerracotta{Colour: c})
 		world.RegisterItem("minecraft:carpet", Carpet{Colour: c})
 		world.RegisterItem("minecraft:wool", Wool{Colour: c})
@@ -125,10 +157,70 @@ func init() {
 	world.RegisterItem("minecraft:emerald_block", EmeraldBlock{})
 	world.RegisterItem("minecraft:gold_block", GoldBlock{})
 	world.RegisterItem("minecraft:iron_block", IronBlock{})
+	world.RegisterItem("minecraft:gold_ore", GoldOre{})
+	world.RegisterItem("minecraft:iron_ore", IronOre{})
 	world.RegisterItem("minecraft:beacon", Beacon{})
 	world.RegisterItem("minecraft:sponge", Sponge{})
 	world.RegisterItem("minecraft:wet_sponge", Sponge{Wet: true})
+	world.RegisterItem("minecraft:crafting_table", CraftingTable{})
 	world.RegisterItem("minecraft:hardened_clay", Terracotta{})
+	world.RegisterItem("minecraft:tnt", TNT{})
+	world.RegisterItem("minecraft:redstone", RedstoneDust{})
+	world.RegisterItem("minecraft:redstone_torch", RedstoneTorch{Lit: true})
+	world.RegisterItem("minecraft:lever", Lever{})
+	world.RegisterItem("minecraft:stone_button", StoneButton{})
+	world.RegisterItem("minecraft:stone_pressure_plate", StonePressurePlate{})
+	for _, w := range wood.All() {
+		buttonName, plateName := "minecraft:"+w.String()+"_button", "minecraft:"+w.String()+"_pressure_plate"
+		if w == wood.Oak() {
+			buttonName, plateName = "minecraft:wooden_button", "minecraft:wooden_pressure_plate"
+		}
+		world.RegisterItem(buttonName, WoodButton{Wood: w})
+		world.RegisterItem(plateName, WoodPressurePlate{Wood: w})
+	}
+	world.RegisterItem("minecraft:light_weighted_pressure_plate", WeightedPressurePlate{})
+	world.RegisterItem("minecraft:heavy_weighted_pressure_plate", WeightedPressurePlate{Heavy: true})
+	world.RegisterItem("minecraft:repeater", Repeater{})
+	world.RegisterItem("minecraft:comparator", Comparator{})
+	world.RegisterItem("minecraft:redstone_lamp", RedstoneLamp{})
+	world.RegisterItem("minecraft:redstone_block", RedstoneBlock{})
+	for _, w := range wood.All() {
+		doorName, trapdoorName, gateName := "minecraft:"+w.String()+"_door", "minecraft:"+w.String()+"_trapdoor", "minecraft:"+w.String()+"_fence_gate"
+		if w == wood.Oak() {
+			doorName, trapdoorName, gateName = "minecraft:wooden_door", "minecraft:trapdoor", "minecraft:fence_gate"
+		}
+		world.RegisterItem(doorName, WoodDoor{Wood: w})
+		world.RegisterItem(trapdoorName, WoodTrapdoor{Wood: w})
+		world.RegisterItem(gateName, WoodFenceGate{Wood: w})
+	}
+	world.RegisterItem("minecraft:iron_door", IronDoor{})
+	world.RegisterItem("minecraft:iron_trapdoor", IronTrapdoor{})
+	world.RegisterItem("minecraft:sand", Sand{})
+	world.RegisterItem("minecraft:sand", Sand{Red: true})
+	world.RegisterItem("minecraft:gravel", Gravel{})
+	world.RegisterItem("minecraft:anvil", Anvil{})
+	world.RegisterItem("minecraft:anvil", Anvil{Damage: 1})
+	world.RegisterItem("minecraft:anvil", Anvil{Damage: 2})
+	world.RegisterItem("minecraft:wheat_seeds", WheatSeeds{})
+	world.RegisterItem("minecraft:carrot", Carrot{})
+	world.RegisterItem("minecraft:potato", Potato{})
+	world.RegisterItem("minecraft:beetroot_seeds", BeetrootSeeds{})
+	world.RegisterItem("minecraft:pumpkin_seeds", PumpkinSeeds{})
+	world.RegisterItem("minecraft:melon_seeds", MelonSeeds{})
+	world.RegisterItem("minecraft:pumpkin", Pumpkin{})
+	world.RegisterItem("minecraft:melon_block", Melon{})
+	for _, w := range wood.All() {
+		world.RegisterItem("minecraft:sapling", Sapling{Wood: w})
+	}
+	world.RegisterItem("minecraft:furnace", Furnace{})
+	world.RegisterItem("minecraft:blast_furnace", BlastFurnace{})
+	world.RegisterItem("minecraft:smoker", Smoker{})
+	for _, w := range wood.All() {
+		world.RegisterItem("minecraft:"+w.String()+"_sign", Sign{Wood: w})
+	}
+	for _, c := range colour.All() {
+		world.RegisterItem("minecraft:bed", Bed{Colour: c})
+	}
 }
 
 func init() {
@@ -151,6 +243,19 @@ func init() {
 		return ok
 	}
 	item_internal.Replaceable = replaceable
+	item_internal.Till = func(b world.Block) (world.Block, bool) {
+		switch b := b.(type) {
+		case Grass:
+			return Farmland{}, true
+		case Dirt:
+			if b.Coarse {
+				return Dirt{}, true
+			}
+			return Farmland{}, true
+		}
+		return nil, false
+	}
+	item_internal.Fire = Fire{}
 }
 
 // readSlice reads an interface slice from a map at the key passed.
@@ -170,3 +275,30 @@ func readString(m map[string]interface{}, key string) string {
 	b, _ := v.(string)
 	return b
 }
+
+// readByte reads a byte from a map at the key passed.
+//noinspection GoCommentLeadingSpace
+func readByte(m map[string]interface{}, key string) byte {
+	//lint:ignore S1005 Double assignment is done explicitly to prevent panics.
+	v, _ := m[key]
+	b, _ := v.(byte)
+	return b
+}
+
+// readInt16 reads an int16 from a map at the key passed.
+//noinspection GoCommentLeadingSpace
+func readInt16(m map[string]interface{}, key string) int16 {
+	//lint:ignore S1005 Double assignment is done explicitly to prevent panics.
+	v, _ := m[key]
+	b, _ := v.(int16)
+	return b
+}
+
+// readInt32 reads an int32 from a map at the key passed.
+//noinspection GoCommentLeadingSpace
+func readInt32(m map[string]interface{}, key string) int32 {
+	//lint:ignore S1005 Double assignment is done explicitly to prevent panics.
+	v, _ := m[key]
+	b, _ := v.(int32)
+	return b
+}
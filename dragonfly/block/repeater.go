@@ -0,0 +1,161 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// Repeater is a redstone component that repeats the power it receives from behind at full strength after a
+// delay. Repeaters are locked if a powered repeater or comparator faces into their side.
+type Repeater struct {
+	// Facing is the direction that the repeater outputs power in. The repeater receives power from the
+	// opposite direction.
+	Facing world.Direction
+	// Delay is the delay of the repeater, from 0-3. A delay of 0 delays the power by one redstone tick (a
+	// tenth of a second) and a delay of 3 delays it by four redstone ticks.
+	Delay int
+	// Powered specifies if the repeater is currently emitting power.
+	Powered bool
+}
+
+// AABB ...
+func (Repeater) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return []physics.AABB{physics.NewAABB(mgl64.Vec3{}, mgl64.Vec3{1, 0.125, 1})}
+}
+
+// BreakInfo ...
+func (r Repeater) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(Repeater{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (Repeater) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// HasLiquidDrops ...
+func (Repeater) HasLiquidDrops() bool {
+	return true
+}
+
+// UseOnBlock places the repeater on top of the block clicked, facing away from the user.
+func (r Repeater) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, r)
+	if !used || !redstoneSupported(pos, world.FaceDown, w) {
+		return false
+	}
+	r.Facing = user.Facing()
+	place(w, pos, r, user, ctx)
+	return placed(ctx)
+}
+
+// Activate cycles the delay of the repeater.
+func (r Repeater) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	r.Delay = (r.Delay + 1) % 4
+	w.SetBlock(pos, r)
+}
+
+// NeighbourUpdateTick removes the repeater if the block below it is removed and schedules an update if the
+// power received by the repeater changed.
+func (r Repeater) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !redstoneSupported(pos, world.FaceDown, w) {
+		w.BreakBlock(pos)
+		return
+	}
+	if !r.Locked(pos, w) && r.Powered != r.inputPowered(pos, w) {
+		w.ScheduleBlockUpdate(pos, r.delay())
+	}
+}
+
+// ScheduledTick updates the output of the repeater. A repeater that is turned on by a pulse shorter than its
+// delay stays on for at least the length of its delay.
+func (r Repeater) ScheduledTick(pos world.BlockPos, w *world.World) {
+	if r.Locked(pos, w) {
+		return
+	}
+	input := r.inputPowered(pos, w)
+	if !r.Powered {
+		r.Powered = true
+		setRedstoneBlock(pos, r, w)
+		if !input {
+			w.ScheduleBlockUpdate(pos, r.delay())
+		}
+	} else if !input {
+		r.Powered = false
+		setRedstoneBlock(pos, r, w)
+	}
+}
+
+// Locked checks if the repeater at the position passed is locked. A repeater is locked if a powered repeater
+// or comparator faces into one of its sides. Locked repeaters keep their output.
+func (r Repeater) Locked(pos world.BlockPos, w *world.World) bool {
+	for _, d := range []world.Direction{r.Facing.Rotate90(), r.Facing.Rotate90().Opposite()} {
+		switch side := w.Block(pos.Side(d.Face())).(type) {
+		case Repeater:
+			if side.Powered && side.Facing == d.Opposite() {
+				return true
+			}
+		case Comparator:
+			if side.Power > 0 && side.Facing == d.Opposite() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// inputPowered checks if the repeater receives power from behind.
+func (r Repeater) inputPowered(pos world.BlockPos, w *world.World) bool {
+	return w.RedstonePower(pos, r.Facing.Opposite().Face()) > 0
+}
+
+// delay returns the delay after which the repeater updates its output.
+func (r Repeater) delay() time.Duration {
+	return time.Duration(r.Delay+1) * time.Second / 10
+}
+
+// WeakPower ...
+func (r Repeater) WeakPower(_ world.BlockPos, face world.Face, _ *world.World) int {
+	if r.Powered && face == r.Facing.Face() {
+		return 15
+	}
+	return 0
+}
+
+// StrongPower ...
+func (r Repeater) StrongPower(pos world.BlockPos, face world.Face, w *world.World) int {
+	return r.WeakPower(pos, face, w)
+}
+
+// EncodeItem ...
+func (Repeater) EncodeItem() (id int32, meta int16) {
+	return 356, 0
+}
+
+// EncodeBlock ...
+func (r Repeater) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:unpowered_repeater"
+	if r.Powered {
+		name = "minecraft:powered_repeater"
+	}
+	return name, map[string]interface{}{"direction": horizontalDirection(r.Facing.Opposite()), "repeater_delay": int32(r.Delay)}
+}
+
+// allRepeaters returns all possible states of repeaters.
+func allRepeaters() (repeaters []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		for delay := 0; delay < 4; delay++ {
+			repeaters = append(repeaters, Repeater{Facing: d, Delay: delay}, Repeater{Facing: d, Delay: delay, Powered: true})
+		}
+	}
+	return
+}
@@ -0,0 +1,174 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+)
+
+// Sapling is a non-solid block that grows into a tree over time. Dark oak saplings only grow into a tree if
+// they are planted in a square of four saplings.
+type Sapling struct {
+	// Wood is the type of wood of the sapling. This field must have one of the values found in the wood
+	// package.
+	Wood wood.Wood
+	// Ready specifies if the sapling is ready to grow. A sapling becomes ready to grow during a random tick
+	// and grows into a tree during a later random tick.
+	Ready bool
+}
+
+// UseOnBlock plants the sapling on the block clicked if it is dirt, grass or farmland.
+func (s Sapling) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, s)
+	if !used || !saplingSupported(pos, w) {
+		return false
+	}
+	place(w, pos, s, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick breaks the sapling if the block below it is removed.
+func (s Sapling) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !saplingSupported(pos, w) {
+		breakBlock(s, pos, w)
+	}
+}
+
+// RandomTick has a chance to make the sapling ready to grow or to grow it into a tree if the light level at
+// the sapling is at least 9.
+func (s Sapling) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if w.Light(pos) >= 9 && r.Intn(7) == 0 {
+		s.advance(pos, w, r)
+	}
+}
+
+// BoneMeal has a chance to make the sapling ready to grow or to grow it into a tree.
+func (s Sapling) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if rand.Float64() < 0.45 {
+		s.advance(pos, w, rand.New(rand.NewSource(rand.Int63())))
+	}
+	return true
+}
+
+// advance makes the sapling ready to grow if it was not yet ready, or grows it into a tree if it was.
+func (s Sapling) advance(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if !s.Ready {
+		s.Ready = true
+		w.SetBlock(pos, s)
+		return
+	}
+	s.grow(pos, w, r)
+}
+
+// grow attempts to grow the sapling at the position passed into a tree. The tree is only grown if there is
+// enough space for its trunk and if Handler.HandleCropGrowth does not cancel it.
+func (s Sapling) grow(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	trunk := pos
+	if s.Wood == wood.DarkOak() {
+		var ok bool
+		if trunk, ok = s.square(pos, w); !ok {
+			return
+		}
+	}
+	t := NewTree(s.Wood, r)
+	if !t.Fits(trunk, w) {
+		return
+	}
+	if growCrop(pos, w, s, Log{Wood: s.Wood}) {
+		t.Build(trunk, w)
+	}
+}
+
+// square finds a square of four saplings of the same wood type that the sapling at the position passed is
+// part of. The position of the sapling in the square with the lowest x and z is returned if found.
+func (s Sapling) square(pos world.BlockPos, w *world.World) (world.BlockPos, bool) {
+	for _, offset := range []world.BlockPos{{0, 0, 0}, {-1, 0, 0}, {0, 0, -1}, {-1, 0, -1}} {
+		corner, found := pos.Add(offset), true
+		for _, p := range []world.BlockPos{{0, 0, 0}, {1, 0, 0}, {0, 0, 1}, {1, 0, 1}} {
+			if sapling, ok := w.Block(corner.Add(p)).(Sapling); !ok || sapling.Wood != s.Wood {
+				found = false
+				break
+			}
+		}
+		if found {
+			return corner, true
+		}
+	}
+	return pos, false
+}
+
+// saplingSupported checks if the block below the position passed is able to support a sapling.
+func saplingSupported(pos world.BlockPos, w *world.World) bool {
+	switch w.Block(pos.Side(world.FaceDown)).(type) {
+	case Grass, Dirt, Farmland:
+		return true
+	}
+	return false
+}
+
+// AABB ...
+func (Sapling) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (Sapling) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (Sapling) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (s Sapling) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(Sapling{Wood: s.Wood}, 1)),
+	}
+}
+
+// FuelInfo ...
+func (Sapling) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 5}
+}
+
+// EncodeItem ...
+func (s Sapling) EncodeItem() (id int32, meta int16) {
+	switch s.Wood {
+	case wood.Oak():
+		return 6, 0
+	case wood.Spruce():
+		return 6, 1
+	case wood.Birch():
+		return 6, 2
+	case wood.Jungle():
+		return 6, 3
+	case wood.Acacia():
+		return 6, 4
+	case wood.DarkOak():
+		return 6, 5
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (s Sapling) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:sapling", map[string]interface{}{"sapling_type": s.Wood.String(), "age_bit": s.Ready}
+}
+
+// allSaplings returns all possible states of saplings.
+func allSaplings() (saplings []world.Block) {
+	for _, w := range wood.All() {
+		saplings = append(saplings, Sapling{Wood: w}, Sapling{Wood: w, Ready: true})
+	}
+	return
+}
@@ -0,0 +1,159 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"time"
+)
+
+// Sign is a non-solid block that can display text. Signs may either stand on top of a block or be attached
+// to the side of one.
+type Sign struct {
+	// Wood is the type of wood of the sign. This field must have one of the values found in the material
+	// package.
+	Wood wood.Wood
+	// Facing is the face of the block that the sign is attached to. world.FaceDown means the sign is standing
+	// on top of a block, in which case its Rotation is used.
+	Facing world.Face
+	// Rotation is the rotation of a standing sign, ranging from 0-15. Every step rotates the sign by 22.5
+	// degrees clockwise, with 0 meaning the sign faces south.
+	Rotation int
+	// Text is the text displayed on the sign. Lines of the text are separated by a newline.
+	Text string
+}
+
+// SignEditor represents an entity that is able to edit the text of a sign, such as a player. The sign editor
+// is opened for the entity after it places a sign.
+type SignEditor interface {
+	// OpenSign opens the editor of the sign at the position passed.
+	OpenSign(pos world.BlockPos)
+}
+
+// HeldText returns the text displayed on the sign.
+func (s Sign) HeldText() string {
+	return s.Text
+}
+
+// WithText returns the sign with its text changed to the text passed.
+func (s Sign) WithText(text string) world.Block {
+	s.Text = text
+	return s
+}
+
+// UseOnBlock places the sign on top of the block clicked or against its side, opening the sign editor for
+// the user if possible.
+func (s Sign) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, s)
+	if !used || face == world.FaceDown {
+		return false
+	}
+	s.Facing = face.Opposite()
+	if s.Facing == world.FaceDown {
+		s.Rotation = int(math.Floor((user.Yaw()+180)*16/360+0.5)) & 0xf
+	}
+	if !signSupported(pos, s.Facing, w) {
+		return false
+	}
+	place(w, pos, s, user, ctx)
+	if placed(ctx) {
+		if editor, ok := user.(SignEditor); ok {
+			editor.OpenSign(pos)
+		}
+	}
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick breaks the sign if the block that it is attached to is removed.
+func (s Sign) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !signSupported(pos, s.Facing, w) {
+		breakBlock(s, pos, w)
+	}
+}
+
+// signSupported checks if a sign at the position passed is supported by the block on the face passed.
+func signSupported(pos world.BlockPos, face world.Face, w *world.World) bool {
+	return !replaceable(w, pos.Side(face), Sign{})
+}
+
+// AABB ...
+func (Sign) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// BreakInfo ...
+func (s Sign) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        1,
+		BlastResistance: 1,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(Sign{Wood: s.Wood}, 1)),
+	}
+}
+
+// FuelInfo ...
+func (Sign) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 10}
+}
+
+// DecodeNBT ...
+func (s Sign) DecodeNBT(data map[string]interface{}) interface{} {
+	s.Text = readString(data, "Text")
+	return s
+}
+
+// EncodeNBT ...
+func (s Sign) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{"id": "Sign", "Text": s.Text}
+}
+
+// EncodeItem ...
+func (s Sign) EncodeItem() (id int32, meta int16) {
+	switch s.Wood {
+	case wood.Oak():
+		return 323, 0
+	case wood.Spruce():
+		return 472, 0
+	case wood.Birch():
+		return 473, 0
+	case wood.Jungle():
+		return 474, 0
+	case wood.Acacia():
+		return 475, 0
+	case wood.DarkOak():
+		return 476, 0
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (s Sign) EncodeBlock() (name string, properties map[string]interface{}) {
+	prefix := "minecraft:" + s.Wood.String() + "_"
+	switch s.Wood {
+	case wood.Oak():
+		prefix = "minecraft:"
+	case wood.DarkOak():
+		prefix = "minecraft:darkoak_"
+	}
+	if s.Facing == world.FaceDown {
+		return prefix + "standing_sign", map[string]interface{}{"ground_sign_direction": int32(s.Rotation)}
+	}
+	return prefix + "wall_sign", map[string]interface{}{"facing_direction": int32(s.Facing.Opposite())}
+}
+
+// allSigns returns a list of all states of standing and wall signs of every type of wood.
+func allSigns() (signs []world.Block) {
+	for _, w := range wood.All() {
+		for rotation := 0; rotation < 16; rotation++ {
+			signs = append(signs, Sign{Wood: w, Rotation: rotation})
+		}
+		for _, f := range []world.Face{world.FaceNorth, world.FaceSouth, world.FaceWest, world.FaceEast} {
+			signs = append(signs, Sign{Wood: w, Facing: f})
+		}
+	}
+	return
+}
@@ -0,0 +1,216 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/internal/block_internal"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/inventory"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"math"
+	"sync"
+	"time"
+)
+
+// Smelter represents a container that smelts items, such as a furnace. Experience is stored in the smelter
+// for every item smelted, which may be collected by players taking items out of it or breaking it.
+type Smelter interface {
+	Container
+	// CollectExperience collects the whole experience points stored in the smelter, returning the amount
+	// collected.
+	CollectExperience() int
+}
+
+// smelter holds the state shared by furnaces, blast furnaces and smokers. It holds the inventory of the block
+// and keeps track of the item currently being smelted and the fuel currently burning.
+type smelter struct {
+	inventory *inventory.Inventory
+	viewerMu  sync.RWMutex
+	viewers   []ContainerViewer
+
+	mu sync.Mutex
+	// remainingDuration is the duration that the fuel currently burning will keep burning for, and
+	// maxDuration is the total duration that it burns for.
+	remainingDuration, maxDuration time.Duration
+	// cookDuration is the duration that the item in the input slot has been smelting for.
+	cookDuration time.Duration
+	// experience is the experience stored from smelting items. It may be collected using CollectExperience.
+	experience float64
+}
+
+const (
+	// smelterInput, smelterFuel and smelterOutput are the slots of the input, fuel and output in the
+	// inventory of a smelter.
+	smelterInput, smelterFuel, smelterOutput = 0, 1, 2
+	// furnaceCookDuration is the duration that a furnace takes to smelt a single item. Blast furnaces and
+	// smokers take half as long, but also burn their fuel twice as fast.
+	furnaceCookDuration = time.Second * 10
+)
+
+// newSmelter creates a new initialised smelter with an empty inventory.
+func newSmelter() *smelter {
+	s := &smelter{}
+	s.inventory = inventory.New(3, func(slot int, item item.Stack) {
+		s.viewerMu.RLock()
+		for _, viewer := range s.viewers {
+			viewer.ViewSlotChange(slot, item)
+		}
+		s.viewerMu.RUnlock()
+	})
+	return s
+}
+
+// Inventory returns the inventory of the block. It holds the input, fuel and output slots, in that order.
+func (s *smelter) Inventory() *inventory.Inventory {
+	return s.inventory
+}
+
+// AddViewer adds a viewer to the block, so that it is updated whenever the inventory of the block or the
+// progress of smelting changes.
+func (s *smelter) AddViewer(v ContainerViewer, _ *world.World, _ world.BlockPos) {
+	s.viewerMu.Lock()
+	s.viewers = append(s.viewers, v)
+	s.viewerMu.Unlock()
+
+	if viewer, ok := v.(FurnaceViewer); ok {
+		s.mu.Lock()
+		cook, remaining, max := s.cookDuration, s.remainingDuration, s.maxDuration
+		s.mu.Unlock()
+		viewer.ViewFurnaceProgress(cook, remaining, max)
+	}
+}
+
+// RemoveViewer removes a viewer from the block, so that updates are no longer sent to it.
+func (s *smelter) RemoveViewer(v ContainerViewer, _ *world.World, _ world.BlockPos) {
+	s.viewerMu.Lock()
+	defer s.viewerMu.Unlock()
+	for i, viewer := range s.viewers {
+		if viewer == v {
+			s.viewers = append(s.viewers[:i], s.viewers[i+1:]...)
+			return
+		}
+	}
+}
+
+// CollectExperience collects the experience stored in the block from smelting items, returning it and
+// resetting the experience stored to 0. Only whole experience points are collected: The remaining fraction
+// is kept in the block.
+func (s *smelter) CollectExperience() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	xp := math.Floor(s.experience)
+	s.experience -= xp
+	return int(xp)
+}
+
+// tickSmelting ticks the smelter, burning fuel and smelting the item in the input slot if it has a recipe
+// for the block with the name passed. Items take the cook duration passed to smelt. tickSmelting returns
+// true if fuel is still burning after the tick, meaning the block should be lit.
+func (s *smelter) tickSmelting(requirement time.Duration, block string) (lit bool) {
+	if s == nil {
+		// Blocks set without being initialised using their constructor have no smelter.
+		return false
+	}
+	s.mu.Lock()
+	prevCook, prevRemaining, prevMax := s.cookDuration, s.remainingDuration, s.maxDuration
+
+	input, _ := s.inventory.Item(smelterInput)
+	fuel, _ := s.inventory.Item(smelterFuel)
+	product, _ := s.inventory.Item(smelterOutput)
+
+	output, experience, canSmelt := block_internal.Smelt(input, block)
+	canSmelt = canSmelt && !input.Empty() && (product.Empty() || (product.Comparable(output) && product.Count()+output.Count() <= product.MaxCount()))
+
+	if s.remainingDuration > 0 {
+		s.remainingDuration -= time.Second / 20
+	}
+	if s.remainingDuration <= 0 && canSmelt {
+		if f, ok := fuel.Item().(item.Fuel); ok && f.FuelInfo().Duration > 0 {
+			info := f.FuelInfo()
+			s.maxDuration = time.Duration(float64(info.Duration) * (float64(requirement) / float64(furnaceCookDuration)))
+			s.remainingDuration = s.maxDuration
+
+			if fuel = fuel.Grow(-1); fuel.Empty() {
+				fuel = info.Residue
+			}
+			_ = s.inventory.SetItem(smelterFuel, fuel)
+		}
+	}
+	if s.remainingDuration < 0 {
+		s.remainingDuration = 0
+	}
+
+	switch {
+	case s.remainingDuration > 0 && canSmelt:
+		if s.cookDuration += time.Second / 20; s.cookDuration >= requirement {
+			s.cookDuration = 0
+			if product.Empty() {
+				product = output
+			} else {
+				product = product.Grow(output.Count())
+			}
+			_ = s.inventory.SetItem(smelterInput, input.Grow(-1))
+			_ = s.inventory.SetItem(smelterOutput, product)
+			s.experience += experience
+		}
+	case !canSmelt:
+		s.cookDuration = 0
+	case s.cookDuration > 0:
+		// The smelter ran out of fuel while smelting, so the progress slowly goes back.
+		if s.cookDuration -= time.Second / 10; s.cookDuration < 0 {
+			s.cookDuration = 0
+		}
+	}
+	cook, remaining, max := s.cookDuration, s.remainingDuration, s.maxDuration
+	s.mu.Unlock()
+
+	if cook != prevCook || remaining != prevRemaining || max != prevMax {
+		s.viewerMu.RLock()
+		for _, v := range s.viewers {
+			if viewer, ok := v.(FurnaceViewer); ok {
+				viewer.ViewFurnaceProgress(cook, remaining, max)
+			}
+		}
+		s.viewerMu.RUnlock()
+	}
+	return remaining > 0
+}
+
+// drops returns the items held in the inventory of the smelter, if any.
+func (s *smelter) drops() []item.Stack {
+	if s == nil {
+		return nil
+	}
+	return s.inventory.Contents()
+}
+
+// decodeNBT decodes the inventory, progress and experience stored of the smelter from the data passed.
+func (s *smelter) decodeNBT(data map[string]interface{}) {
+	nbtconv.InvFromNBT(s.inventory, readSlice(data, "Items"))
+	s.remainingDuration = time.Duration(readInt16(data, "BurnTime")) * time.Second / 20
+	s.maxDuration = time.Duration(readInt16(data, "BurnDuration")) * time.Second / 20
+	s.cookDuration = time.Duration(readInt16(data, "CookTime")) * time.Second / 20
+	s.experience = float64(readInt32(data, "StoredXPInt"))
+	if xp, ok := data["StoredXP"].(float32); ok {
+		// StoredXPInt only holds whole experience points, so the fraction is stored separately.
+		s.experience = float64(xp)
+	}
+}
+
+// encodeNBT encodes the inventory, progress and experience stored of the smelter into a map, using the
+// block entity ID passed.
+func (s *smelter) encodeNBT(id string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return map[string]interface{}{
+		"id":           id,
+		"Items":        nbtconv.InvToNBT(s.inventory),
+		"BurnTime":     int16(s.remainingDuration / (time.Second / 20)),
+		"BurnDuration": int16(s.maxDuration / (time.Second / 20)),
+		"CookTime":     int16(s.cookDuration / (time.Second / 20)),
+		"StoredXPInt":  int32(s.experience),
+		"StoredXP":     float32(s.experience),
+	}
+}
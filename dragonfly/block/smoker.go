@@ -0,0 +1,106 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Smoker is a furnace that cooks food twice as fast as a regular furnace, while burning its fuel twice
+// as fast too. It is unable to smelt anything else.
+// The empty value of Smoker is not valid. It must be created using block.NewSmoker().
+type Smoker struct {
+	*smelter
+
+	// Facing is the direction that the smoker is facing.
+	Facing world.Direction
+	// Lit specifies if the smoker is lit, meaning it is currently burning fuel.
+	Lit bool
+}
+
+// NewSmoker creates a new initialised smoker facing the direction passed. Its inventory is properly
+// initialised.
+func NewSmoker(face world.Direction) Smoker {
+	return Smoker{smelter: newSmelter(), Facing: face}
+}
+
+// Tick smelts the item in the input slot of the smoker using the fuel in the fuel slot, lighting or
+// extinguishing the smoker as the fuel starts or stops burning.
+func (s Smoker) Tick(_ int64, pos world.BlockPos, w *world.World) {
+	if lit := s.tickSmelting(furnaceCookDuration/2, "smoker"); lit != s.Lit {
+		s.Lit = lit
+		w.SetBlock(pos, s)
+	}
+}
+
+// Activate ...
+func (s Smoker) Activate(pos world.BlockPos, _ world.Face, _ *world.World, u item.User) {
+	if opener, ok := u.(ContainerOpener); ok {
+		opener.OpenBlockContainer(pos)
+	}
+}
+
+// UseOnBlock ...
+func (s Smoker) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, _, used = firstReplaceable(w, pos, face, s)
+	if !used {
+		return
+	}
+	place(w, pos, NewSmoker(user.Facing().Opposite()), user, ctx)
+	return placed(ctx)
+}
+
+// BreakInfo ...
+func (s Smoker) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        3.5,
+		BlastResistance: 3.5,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(append(s.drops(), item.NewStack(Smoker{}, 1))...),
+	}
+}
+
+// LightEmissionLevel ...
+func (s Smoker) LightEmissionLevel() uint8 {
+	if s.Lit {
+		return 13
+	}
+	return 0
+}
+
+// DecodeNBT ...
+func (s Smoker) DecodeNBT(data map[string]interface{}) interface{} {
+	s.smelter = newSmelter()
+	s.decodeNBT(data)
+	return s
+}
+
+// EncodeNBT ...
+func (s Smoker) EncodeNBT() map[string]interface{} {
+	if s.smelter == nil {
+		s.smelter = newSmelter()
+	}
+	return s.encodeNBT("Smoker")
+}
+
+// EncodeItem ...
+func (s Smoker) EncodeItem() (id int32, meta int16) {
+	return -198, 0
+}
+
+// EncodeBlock ...
+func (s Smoker) EncodeBlock() (name string, properties map[string]interface{}) {
+	if s.Lit {
+		return "minecraft:lit_smoker", map[string]interface{}{"facing_direction": 2 + int32(s.Facing)}
+	}
+	return "minecraft:smoker", map[string]interface{}{"facing_direction": 2 + int32(s.Facing)}
+}
+
+// allSmokers returns all states of the smoker.
+func allSmokers() (b []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		b = append(b, Smoker{Facing: d}, Smoker{Facing: d, Lit: true})
+	}
+	return
+}
@@ -17,10 +17,11 @@ type Sponge struct {
 // BreakInfo ...
 func (s Sponge) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    0.6,
-		Drops:       simpleDrops(item.NewStack(s, 1)),
-		Effective:   nothingEffective,
-		Harvestable: alwaysHarvestable,
+		Hardness:        0.6,
+		BlastResistance: 0.6,
+		Drops:           simpleDrops(item.NewStack(s, 1)),
+		Effective:       nothingEffective,
+		Harvestable:     alwaysHarvestable,
 	}
 }
 
@@ -16,10 +16,11 @@ type StainedTerracotta struct {
 // BreakInfo ...
 func (t StainedTerracotta) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    1.25,
-		Harvestable: pickaxeHarvestable,
-		Effective:   pickaxeEffective,
-		Drops:       simpleDrops(item.NewStack(t, 1)),
+		Hardness:        1.25,
+		BlastResistance: 4.2,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(t, 1)),
 	}
 }
 
@@ -0,0 +1,36 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"math/rand"
+)
+
+// growStemFruit attempts to grow the fruit passed next to the fully grown stem at the position passed. The
+// fruit is grown in a random direction on an air block above farmland, dirt or grass. The direction that the
+// fruit was grown in is returned, along with a bool indicating if the fruit was grown.
+func growStemFruit(pos world.BlockPos, w *world.World, r *rand.Rand, fruit world.Block) (world.Direction, bool) {
+	d := world.Direction(r.Intn(4))
+	fruitPos := pos.Side(d.Face())
+	if _, ok := w.Block(fruitPos).(Air); !ok {
+		return 0, false
+	}
+	switch w.Block(fruitPos.Side(world.FaceDown)).(type) {
+	case Farmland, Dirt, Grass:
+		return d, growCrop(fruitPos, w, Air{}, fruit)
+	}
+	return 0, false
+}
+
+// stemDrops returns the amount of seeds dropped by a stem with the growth stage passed.
+func stemDrops(growth int) int {
+	return binomial(3, float64(growth+1)/15)
+}
+
+// stemProperties returns the block properties of a stem with the values passed.
+func stemProperties(growth int, attached bool, facing world.Direction) map[string]interface{} {
+	direction := int32(world.FaceDown)
+	if attached {
+		direction = int32(facing.Face())
+	}
+	return map[string]interface{}{"growth": int32(growth), "facing_direction": direction}
+}
@@ -24,10 +24,11 @@ type (
 )
 
 var stoneBreakInfo = BreakInfo{
-	Hardness:    1.5,
-	Harvestable: pickaxeHarvestable,
-	Effective:   pickaxeEffective,
-	Drops:       simpleDrops(item.NewStack(Cobblestone{}, 1)),
+	Hardness:        1.5,
+	BlastResistance: 6,
+	Harvestable:     pickaxeHarvestable,
+	Effective:       pickaxeEffective,
+	Drops:           simpleDrops(item.NewStack(Cobblestone{}, 1)),
 }
 
 // BreakInfo ...
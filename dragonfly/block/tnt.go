@@ -0,0 +1,56 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// TNT is an explosive block that can be primed to create an explosion. TNT is ignited using flint and steel,
+// by fire next to it or by other explosions.
+type TNT struct{}
+
+// Ignite ignites the TNT at the position passed, replacing it with primed TNT that explodes after four
+// seconds. False is returned if the TNTExplodes game rule is disabled, in which case the TNT is left
+// untouched.
+func (t TNT) Ignite(pos world.BlockPos, w *world.World) bool {
+	if !w.GameRule(gamerule.TNTExplodes{}).(bool) {
+		return false
+	}
+	w.SetBlock(pos, Air{})
+	w.PlaySound(pos.Vec3Centre(), sound.TNT{})
+	w.AddEntity(entity_newTNT(pos.Vec3Middle(), time.Second*4))
+	return true
+}
+
+// BreakInfo ...
+func (t TNT) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops:           simpleDrops(item.NewStack(t, 1)),
+	}
+}
+
+// EncodeItem ...
+func (TNT) EncodeItem() (id int32, meta int16) {
+	return 46, 0
+}
+
+// EncodeBlock ...
+func (TNT) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:tnt", map[string]interface{}{"allow_underwater_bit": false, "explode_bit": false}
+}
+
+// The following functions use the go:linkname directive in order to create primed TNT without the block
+// package having to import the entity package.
+
+//go:linkname entity_newTNT github.com/df-mc/dragonfly/dragonfly/entity.newTNT
+//noinspection ALL
+func entity_newTNT(pos mgl64.Vec3, fuse time.Duration) world.Entity
@@ -0,0 +1,214 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// WoodTrapdoor is a block that may be opened and closed by hand or using redstone. Closed trapdoors cover the
+// top or bottom part of a block.
+type WoodTrapdoor struct {
+	// Wood is the type of wood of the trapdoor. This field must have one of the values found in the wood
+	// package.
+	Wood wood.Wood
+	// Facing is the direction that the user placing the trapdoor was facing. An open trapdoor is placed
+	// against the side of the block in this direction.
+	Facing world.Direction
+	// Open specifies if the trapdoor is open.
+	Open bool
+	// Top specifies if the trapdoor covers the top part of the block when closed.
+	Top bool
+}
+
+// IronTrapdoor is a block that may only be opened and closed using redstone. Closed trapdoors cover the top or
+// bottom part of a block.
+type IronTrapdoor struct {
+	// Facing is the direction that the user placing the trapdoor was facing. An open trapdoor is placed
+	// against the side of the block in this direction.
+	Facing world.Direction
+	// Open specifies if the trapdoor is open.
+	Open bool
+	// Top specifies if the trapdoor covers the top part of the block when closed.
+	Top bool
+}
+
+// UseOnBlock places the trapdoor in the top or bottom part of the block, depending on where the block was
+// clicked.
+func (t WoodTrapdoor) UseOnBlock(pos world.BlockPos, face world.Face, clickPos mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, t)
+	if !used {
+		return
+	}
+	t.Facing = user.Facing()
+	t.Top = trapdoorTop(face, clickPos)
+	place(w, pos, t, user, ctx)
+	return placed(ctx)
+}
+
+// Activate opens or closes the trapdoor.
+func (t WoodTrapdoor) Activate(pos world.BlockPos, _ world.Face, w *world.World, _ item.User) {
+	t.Open = !t.Open
+	w.SetBlock(pos, t)
+	w.PlaySound(pos.Vec3Centre(), doorSound(t.Open))
+}
+
+// NeighbourUpdateTick opens or closes the trapdoor if the redstone power it receives changes.
+func (t WoodTrapdoor) NeighbourUpdateTick(pos, changed world.BlockPos, w *world.World) {
+	if powered := w.ReceivedRedstonePower(pos) > 0; redstoneChanged(changed, w) && powered != t.Open {
+		t.Open = powered
+		w.SetBlock(pos, t)
+		w.PlaySound(pos.Vec3Centre(), doorSound(t.Open))
+	}
+}
+
+// AABB ...
+func (t WoodTrapdoor) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return trapdoorBoxes(t.Facing, t.Open, t.Top)
+}
+
+// BreakInfo ...
+func (t WoodTrapdoor) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        3,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(WoodTrapdoor{Wood: t.Wood}, 1)),
+	}
+}
+
+// FuelInfo ...
+func (WoodTrapdoor) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
+// LightDiffusionLevel ...
+func (WoodTrapdoor) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// EncodeItem ...
+func (t WoodTrapdoor) EncodeItem() (id int32, meta int16) {
+	switch t.Wood {
+	case wood.Oak():
+		return 96, 0
+	case wood.Spruce():
+		return -149, 0
+	case wood.Birch():
+		return -146, 0
+	case wood.Jungle():
+		return -148, 0
+	case wood.Acacia():
+		return -145, 0
+	case wood.DarkOak():
+		return -147, 0
+	}
+	panic("invalid wood type")
+}
+
+// EncodeBlock ...
+func (t WoodTrapdoor) EncodeBlock() (name string, properties map[string]interface{}) {
+	name = "minecraft:" + t.Wood.String() + "_trapdoor"
+	if t.Wood == wood.Oak() {
+		name = "minecraft:trapdoor"
+	}
+	return name, trapdoorProperties(t.Facing, t.Open, t.Top)
+}
+
+// UseOnBlock places the trapdoor in the top or bottom part of the block, depending on where the block was
+// clicked.
+func (t IronTrapdoor) UseOnBlock(pos world.BlockPos, face world.Face, clickPos mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) (used bool) {
+	pos, face, used = firstReplaceable(w, pos, face, t)
+	if !used {
+		return
+	}
+	t.Facing = user.Facing()
+	t.Top = trapdoorTop(face, clickPos)
+	place(w, pos, t, user, ctx)
+	return placed(ctx)
+}
+
+// NeighbourUpdateTick opens or closes the trapdoor if the redstone power it receives changes.
+func (t IronTrapdoor) NeighbourUpdateTick(pos, changed world.BlockPos, w *world.World) {
+	if powered := w.ReceivedRedstonePower(pos) > 0; redstoneChanged(changed, w) && powered != t.Open {
+		t.Open = powered
+		w.SetBlock(pos, t)
+		w.PlaySound(pos.Vec3Centre(), doorSound(t.Open))
+	}
+}
+
+// AABB ...
+func (t IronTrapdoor) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return trapdoorBoxes(t.Facing, t.Open, t.Top)
+}
+
+// BreakInfo ...
+func (t IronTrapdoor) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        5,
+		BlastResistance: 5,
+		Harvestable:     pickaxeHarvestable,
+		Effective:       pickaxeEffective,
+		Drops:           simpleDrops(item.NewStack(IronTrapdoor{}, 1)),
+	}
+}
+
+// LightDiffusionLevel ...
+func (IronTrapdoor) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// EncodeItem ...
+func (IronTrapdoor) EncodeItem() (id int32, meta int16) {
+	return 167, 0
+}
+
+// EncodeBlock ...
+func (t IronTrapdoor) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:iron_trapdoor", trapdoorProperties(t.Facing, t.Open, t.Top)
+}
+
+// trapdoorTop checks if a trapdoor placed by clicking the face passed at the position passed should cover
+// the top part of the block.
+func trapdoorTop(face world.Face, clickPos mgl64.Vec3) bool {
+	return face == world.FaceDown || (clickPos[1] > 0.5 && face != world.FaceUp)
+}
+
+// trapdoorBoxes returns the collision boxes of a trapdoor.
+func trapdoorBoxes(facing world.Direction, open, top bool) []physics.AABB {
+	switch {
+	case open:
+		return []physics.AABB{panelBox(facing.Face(), 0.1875)}
+	case top:
+		return []physics.AABB{panelBox(world.FaceUp, 0.1875)}
+	}
+	return []physics.AABB{panelBox(world.FaceDown, 0.1875)}
+}
+
+// trapdoorProperties returns the block properties of a trapdoor with the values passed.
+func trapdoorProperties(facing world.Direction, open, top bool) map[string]interface{} {
+	return map[string]interface{}{
+		"direction":       3 - horizontalDirection(facing.Opposite()),
+		"open_bit":        open,
+		"upside_down_bit": top,
+	}
+}
+
+// allTrapdoors returns all possible states of trapdoors.
+func allTrapdoors() (trapdoors []world.Block) {
+	for d := world.North; d <= world.East; d++ {
+		for _, open := range []bool{false, true} {
+			for _, top := range []bool{false, true} {
+				trapdoors = append(trapdoors, IronTrapdoor{Facing: d, Open: open, Top: top})
+				for _, w := range wood.All() {
+					trapdoors = append(trapdoors, WoodTrapdoor{Wood: w, Facing: d, Open: open, Top: top})
+				}
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,154 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"math/rand"
+)
+
+// Tree is a world.Structure of a tree of a specific wood type. Trees are grown from saplings, but may also be
+// built by world generators using World.BuildStructure. Leaves of a tree only replace air and other
+// replaceable blocks, so that trees do not destroy the terrain around them.
+type Tree struct {
+	dim    [3]int
+	trunk  world.BlockPos
+	logs   []world.BlockPos
+	blocks map[world.BlockPos]world.Block
+}
+
+// NewTree creates a new tree of the wood type passed. The height of the tree and the shape of its leaves are
+// decided using the rand.Rand passed. Dark oak trees have a trunk of 2x2 logs, while all other trees have a
+// trunk of a single log.
+func NewTree(w wood.Wood, r *rand.Rand) Tree {
+	t := Tree{blocks: map[world.BlockPos]world.Block{}}
+	leaves := Leaves{Wood: w}
+	switch w {
+	case wood.Spruce():
+		height := 6 + r.Intn(4)
+		t.trunk = world.BlockPos{3, 0, 3}
+		t.dim = [3]int{7, height + 1, 7}
+		radius, maxRadius, startRadius := r.Intn(2), 1, 0
+		for y := height; y >= 1+r.Intn(2); y-- {
+			t.leafLayer(y, radius, 1, true, leaves, r)
+			if radius >= maxRadius {
+				radius, startRadius = startRadius, 1
+				if maxRadius++; maxRadius > 3 {
+					maxRadius = 3
+				}
+			} else {
+				radius++
+			}
+		}
+		t.trunkLogs(height-1, 1, w)
+	case wood.Acacia():
+		height := 5 + r.Intn(3)
+		t.trunk = world.BlockPos{2, 0, 2}
+		t.dim = [3]int{5, height + 1, 5}
+		t.leafLayer(height-1, 2, 1, true, leaves, r)
+		t.leafLayer(height, 1, 1, false, leaves, r)
+		t.trunkLogs(height, 1, w)
+	case wood.DarkOak():
+		height := 6 + r.Intn(3)
+		t.trunk = world.BlockPos{3, 0, 3}
+		t.dim = [3]int{8, height + 2, 8}
+		t.leafLayer(height-2, 3, 2, true, leaves, r)
+		t.leafLayer(height-1, 3, 2, true, leaves, r)
+		t.leafLayer(height, 2, 2, true, leaves, r)
+		t.leafLayer(height+1, 1, 2, false, leaves, r)
+		t.trunkLogs(height, 2, w)
+	default:
+		height := 4 + r.Intn(3)
+		switch w {
+		case wood.Birch():
+			height++
+		case wood.Jungle():
+			height += r.Intn(5)
+		}
+		t.trunk = world.BlockPos{2, 0, 2}
+		t.dim = [3]int{5, height + 1, 5}
+		for y := height - 3; y <= height; y++ {
+			t.leafLayer(y, 1-(y-height)/2, 1, true, leaves, r)
+		}
+		t.trunkLogs(height, 1, w)
+	}
+	return t
+}
+
+// leafLayer adds a square layer of leaves at the height passed around the trunk of the tree. The layer
+// extends radius blocks away from a trunk with the width passed. If randomCorners is true, the corners of the
+// layer have a chance not to be filled.
+func (t *Tree) leafLayer(y, radius, width int, randomCorners bool, leaves Leaves, r *rand.Rand) {
+	for x := -radius; x < width+radius; x++ {
+		for z := -radius; z < width+radius; z++ {
+			corner := (x == -radius || x == width+radius-1) && (z == -radius || z == width+radius-1)
+			if radius > 0 && corner && (!randomCorners || r.Intn(2) == 0) {
+				continue
+			}
+			t.blocks[t.trunk.Add(world.BlockPos{x, y, z})] = leaves
+		}
+	}
+}
+
+// trunkLogs adds the logs of the trunk of the tree up to the height passed. The trunk has the width passed.
+func (t *Tree) trunkLogs(height, width int, w wood.Wood) {
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			for z := 0; z < width; z++ {
+				pos := t.trunk.Add(world.BlockPos{x, y, z})
+				t.blocks[pos] = Log{Wood: w}
+				t.logs = append(t.logs, pos)
+			}
+		}
+	}
+}
+
+// Dimensions ...
+func (t Tree) Dimensions() [3]int {
+	return t.dim
+}
+
+// At returns the log or leaves of the tree at the position passed. Blocks that are not replaceable by a
+// tree, such as stone, are left as they are.
+func (t Tree) At(x, y, z int, blockAt func(x, y, z int) world.Block) world.Block {
+	b, ok := t.blocks[world.BlockPos{x, y, z}]
+	if !ok || !treeReplaceable(blockAt(x, y, z)) {
+		return nil
+	}
+	return b
+}
+
+// Trunk returns the position of the lowest log of the trunk of the tree, relative to the origin of the
+// structure. A tree should be built at the position of the trunk minus this position. For trees with a trunk
+// of 2x2 logs, the log with the lowest x and z is returned.
+func (t Tree) Trunk() world.BlockPos {
+	return t.trunk
+}
+
+// Fits checks if the tree fits when built with its trunk at the position passed. A tree fits if all of its
+// logs replace only air, leaves, saplings or other replaceable blocks.
+func (t Tree) Fits(pos world.BlockPos, w *world.World) bool {
+	origin := pos.Add(world.BlockPos{-t.trunk[0], -t.trunk[1], -t.trunk[2]})
+	for _, log := range t.logs {
+		p := origin.Add(log)
+		if p.OutOfBounds() || !treeReplaceable(w.Block(p)) {
+			return false
+		}
+	}
+	return true
+}
+
+// Build builds the tree with its trunk at the position passed.
+func (t Tree) Build(pos world.BlockPos, w *world.World) {
+	w.BuildStructure(pos.Add(world.BlockPos{-t.trunk[0], -t.trunk[1], -t.trunk[2]}), t)
+}
+
+// treeReplaceable checks if the block passed may be replaced by a part of a tree.
+func treeReplaceable(b world.Block) bool {
+	switch b := b.(type) {
+	case Air, Leaves, Sapling:
+		return true
+	case Replaceable:
+		return b.ReplaceableBy(Leaves{})
+	}
+	return false
+}
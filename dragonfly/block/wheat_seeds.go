@@ -0,0 +1,94 @@
+package block
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// WheatSeeds are a crop that can be harvested to craft bread, cake, & breed certain mobs. Wheat seeds are
+// planted on farmland and grow into wheat.
+type WheatSeeds struct {
+	// Growth is the stage of growth of the wheat, from 0-7. Wheat with a growth stage of 7 is fully grown.
+	Growth int
+}
+
+// GrowthStage ...
+func (s WheatSeeds) GrowthStage() int {
+	return s.Growth
+}
+
+// UseOnBlock plants the wheat seeds on the farmland clicked.
+func (s WheatSeeds) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, user item.User, ctx *item.UseContext) bool {
+	return placeCrop(pos, face, w, WheatSeeds{}, user, ctx)
+}
+
+// RandomTick has a chance to make the wheat grow a stage.
+func (s WheatSeeds) RandomTick(pos world.BlockPos, w *world.World, r *rand.Rand) {
+	if s.Growth < 7 && cropGrows(s, pos, w, r) {
+		growCrop(pos, w, s, WheatSeeds{Growth: s.Growth + 1})
+	}
+}
+
+// BoneMeal makes the wheat grow between two and five stages.
+func (s WheatSeeds) BoneMeal(pos world.BlockPos, w *world.World) bool {
+	if s.Growth == 7 {
+		return false
+	}
+	return growCrop(pos, w, s, WheatSeeds{Growth: boneMealGrowth(s.Growth)})
+}
+
+// NeighbourUpdateTick breaks the wheat if the farmland below it is removed.
+func (s WheatSeeds) NeighbourUpdateTick(pos, _ world.BlockPos, w *world.World) {
+	if !cropSupported(pos, w) {
+		breakBlock(s, pos, w)
+	}
+}
+
+// AABB ...
+func (WheatSeeds) AABB(world.BlockPos, *world.World) []physics.AABB {
+	return nil
+}
+
+// HasLiquidDrops ...
+func (WheatSeeds) HasLiquidDrops() bool {
+	return true
+}
+
+// LightDiffusionLevel ...
+func (WheatSeeds) LightDiffusionLevel() uint8 {
+	return 0
+}
+
+// BreakInfo ...
+func (s WheatSeeds) BreakInfo() BreakInfo {
+	return BreakInfo{
+		Hardness:        0,
+		BlastResistance: 0,
+		Harvestable:     alwaysHarvestable,
+		Effective:       nothingEffective,
+		Drops: func(tool.Tool) []item.Stack {
+			if s.Growth < 7 {
+				return []item.Stack{item.NewStack(WheatSeeds{}, 1)}
+			}
+			drops := []item.Stack{item.NewStack(item.Wheat{}, 1)}
+			if n := binomial(3, 0.5714); n > 0 {
+				drops = append(drops, item.NewStack(WheatSeeds{}, n))
+			}
+			return drops
+		},
+	}
+}
+
+// EncodeItem ...
+func (WheatSeeds) EncodeItem() (id int32, meta int16) {
+	return 295, 0
+}
+
+// EncodeBlock ...
+func (s WheatSeeds) EncodeBlock() (name string, properties map[string]interface{}) {
+	return "minecraft:wheat", map[string]interface{}{"growth": int32(s.Growth)}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/item/tool"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"time"
 )
 
 // WoodSlab is a half block that allows entities to walk up blocks without jumping.
@@ -60,9 +61,10 @@ func (s WoodSlab) UseOnBlock(pos world.BlockPos, face world.Face, clickPos mgl64
 // BreakInfo ...
 func (s WoodSlab) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    2,
-		Harvestable: alwaysHarvestable,
-		Effective:   axeEffective,
+		Hardness:        2,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
 		Drops: func(t tool.Tool) []item.Stack {
 			if s.Double {
 				s.Double = false
@@ -74,6 +76,11 @@ func (s WoodSlab) BreakInfo() BreakInfo {
 	}
 }
 
+// FuelInfo ...
+func (WoodSlab) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Millisecond * 7500}
+}
+
 // LightDiffusionLevel returns 0 if the slab is a half slab, or 15 if it is double.
 func (s WoodSlab) LightDiffusionLevel() uint8 {
 	if s.Double {
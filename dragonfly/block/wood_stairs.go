@@ -6,6 +6,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"time"
 )
 
 // WoodStairs are blocks that allow entities to walk up blocks without jumping. They are crafted using planks.
@@ -39,13 +40,19 @@ func (s WoodStairs) UseOnBlock(pos world.BlockPos, face world.Face, clickPos mgl
 // BreakInfo ...
 func (s WoodStairs) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    2,
-		Harvestable: alwaysHarvestable,
-		Effective:   axeEffective,
-		Drops:       simpleDrops(item.NewStack(s, 1)),
+		Hardness:        2,
+		BlastResistance: 3,
+		Harvestable:     alwaysHarvestable,
+		Effective:       axeEffective,
+		Drops:           simpleDrops(item.NewStack(s, 1)),
 	}
 }
 
+// FuelInfo ...
+func (WoodStairs) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 15}
+}
+
 // LightDiffusionLevel always returns 0.
 func (WoodStairs) LightDiffusionLevel() uint8 {
 	return 0
@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/block/colour"
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"time"
 )
 
 // Wool is a colourful block that can be obtained by killing/shearing sheep, or crafted using four string.
@@ -14,13 +15,19 @@ type Wool struct {
 // BreakInfo ...
 func (w Wool) BreakInfo() BreakInfo {
 	return BreakInfo{
-		Hardness:    0.8,
-		Harvestable: alwaysHarvestable,
-		Effective:   shearsEffective,
-		Drops:       simpleDrops(item.NewStack(w, 1)),
+		Hardness:        0.8,
+		BlastResistance: 0.8,
+		Harvestable:     alwaysHarvestable,
+		Effective:       shearsEffective,
+		Drops:           simpleDrops(item.NewStack(w, 1)),
 	}
 }
 
+// FuelInfo ...
+func (Wool) FuelInfo() item.FuelInfo {
+	return item.FuelInfo{Duration: time.Second * 5}
+}
+
 // EncodeItem ...
 func (w Wool) EncodeItem() (id int32, meta int16) {
 	return 35, int16(w.Colour.Uint8())
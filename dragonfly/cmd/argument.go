@@ -3,9 +3,12 @@ package cmd
 import (
 	"errors"
 	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
 )
 
 // Line represents a command line holding command arguments that were passed upon the execution of the
@@ -51,6 +54,11 @@ func (line *Line) Len() int {
 // struct fields.
 type parser struct {
 	currentField string
+	// currentName is the name of the parameter currently parsed, as returned by the name function.
+	currentName string
+	// source is the Source executing the command. It is used to resolve arguments that depend on the source,
+	// such as target selectors.
+	source Source
 }
 
 // parseArgument parses the next argument from the command line passed and sets it to value v passed. If
@@ -70,6 +78,12 @@ func (p parser) parseArgument(line *Line, v reflect.Value, optional bool) (err e
 		err = p.bool(line, v)
 	case mgl64.Vec3:
 		err = p.vec3(line, v)
+	case world.BlockPos:
+		err = p.blockPos(line, v)
+	case Target:
+		err = p.target(line, v)
+	case SubCommand:
+		err = p.subCommand(line)
 	default:
 		if param, ok := i.(Parameter); ok {
 			err = param.Parse(line, v)
@@ -178,13 +192,104 @@ func (p parser) enum(line *Line, val reflect.Value, v Enum) error {
 	return nil
 }
 
+// subCommand ...
+func (p parser) subCommand(line *Line) error {
+	arg, ok := line.Next()
+	if !ok {
+		return ErrInsufficientArgs
+	}
+	if lit := strings.ToLower(p.currentName); !strings.EqualFold(arg, lit) {
+		return fmt.Errorf(`unexpected argument "%v": expected "%v"`, arg, lit)
+	}
+	return nil
+}
+
 // vec3 ...
 func (p parser) vec3(line *Line, v reflect.Value) error {
-	if err := p.float(line, v.Index(0)); err != nil {
+	vec, err := p.position(line)
+	if err != nil {
 		return err
 	}
-	if err := p.float(line, v.Index(1)); err != nil {
+	v.Set(reflect.ValueOf(vec))
+	return nil
+}
+
+// blockPos ...
+func (p parser) blockPos(line *Line, v reflect.Value) error {
+	vec, err := p.position(line)
+	if err != nil {
 		return err
 	}
-	return p.float(line, v.Index(2))
+	v.Set(reflect.ValueOf(world.BlockPosFromVec3(vec)))
+	return nil
+}
+
+// position parses a position from the next three arguments of the command line. Each of the coordinates may
+// be absolute, relative to the position of the source (~) or local to the position and rotation of the source
+// (^). Local coordinates cannot be mixed with absolute or relative coordinates.
+func (p parser) position(line *Line) (mgl64.Vec3, error) {
+	args, ok := line.NextN(3)
+	if !ok {
+		return mgl64.Vec3{}, ErrInsufficientArgs
+	}
+	var (
+		coords           mgl64.Vec3
+		relative, local  [3]bool
+		anyLocal, anyRel bool
+	)
+	for i, arg := range args {
+		number := arg
+		switch {
+		case strings.HasPrefix(arg, "~"):
+			relative[i], anyRel, number = true, true, arg[1:]
+		case strings.HasPrefix(arg, "^"):
+			local[i], anyLocal, number = true, true, arg[1:]
+		}
+		if number == "" && (relative[i] || local[i]) {
+			continue
+		}
+		value, err := strconv.ParseFloat(number, 64)
+		if err != nil {
+			return mgl64.Vec3{}, fmt.Errorf(`cannot parse argument "%v" as coordinate for argument "%v"`, arg, p.currentField)
+		}
+		coords[i] = value
+	}
+	if anyLocal && !(local[0] && local[1] && local[2]) {
+		return mgl64.Vec3{}, fmt.Errorf(`cannot mix local coordinates (^) with world coordinates for argument "%v"`, p.currentField)
+	}
+	if !anyLocal && !anyRel {
+		return coords, nil
+	}
+	pos, yaw, pitch, ok := p.origin()
+	if !ok {
+		return mgl64.Vec3{}, fmt.Errorf(`relative and local coordinates cannot be used by this source for argument "%v"`, p.currentField)
+	}
+	if anyLocal {
+		// Local coordinates are offsets to the left, upwards and forwards, relative to the rotation of the
+		// source.
+		yawRad, pitchRad := mgl64.DegToRad(yaw), mgl64.DegToRad(pitch)
+		forward := mgl64.Vec3{-math.Cos(pitchRad) * math.Sin(yawRad), -math.Sin(pitchRad), math.Cos(pitchRad) * math.Cos(yawRad)}
+		left := mgl64.Vec3{math.Cos(yawRad), 0, math.Sin(yawRad)}
+		up := forward.Cross(left)
+		return pos.Add(left.Mul(coords[0])).Add(up.Mul(coords[1])).Add(forward.Mul(coords[2])), nil
+	}
+	for i := range coords {
+		if relative[i] {
+			coords[i] += pos[i]
+		}
+	}
+	return coords, nil
+}
+
+// origin returns the position and rotation that relative and local coordinates are resolved against. If the
+// source implements Positioned, its position and rotation are returned. If not, the spawn of the world of the
+// source is used, if it has a world.
+func (p parser) origin() (pos mgl64.Vec3, yaw, pitch float64, ok bool) {
+	if positioned, ok := p.source.(Positioned); ok {
+		return positioned.Position(), positioned.Yaw(), positioned.Pitch(), true
+	}
+	if w, origin := sourceWorld(p.source); w != nil {
+		return origin, 0, 0, true
+	}
+	return mgl64.Vec3{}, 0, 0, false
 }
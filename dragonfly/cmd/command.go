@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/permission"
+	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"reflect"
 	"strings"
@@ -12,11 +14,14 @@ import (
 // and may be used for behaviour in the Command.
 // A Runnable may have exported fields only of the following types:
 // int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, uint,
-// float32, float64, string, bool, mgl64.Vec3,
+// float32, float64, string, bool, mgl64.Vec3, world.BlockPos, cmd.Varargs, cmd.Target, cmd.SubCommand,
 // or a type that implements the cmd.Parameter or cmd.Enum interface.
 // Fields in the Runnable struct may have the `optional:""` struct tag to mark them as an optional parameter,
 // the `suffix:"$suffix"` struct tag to add a suffix to the parameter in the usage, and the `name:"name"` tag
 // to specify a name different than the field name for the parameter.
+// A field of the Runnable, typically a blank field of type struct{}, may have the `permission:"node"` struct
+// tag to require a permission from the source running the command. Alternatively, the Runnable may implement
+// the Permissioned interface.
 type Runnable interface {
 	// Run runs the Command, using the arguments passed to the Command. The source is passed to the method,
 	// which is the source of the execution of the Command, and the output is passed, to which messages may be
@@ -33,6 +38,15 @@ type Limiter interface {
 	Limit() []Source
 }
 
+// Permissioned may be implemented by a type also implementing Runnable to require a permission from the
+// source running the command. Sources that implement permission.Holder and do not have the permission
+// returned are not able to run the command. Sources that do not implement permission.Holder, such as the
+// console, may always run it.
+type Permissioned interface {
+	// Permission returns the permission node required to run the command, such as 'dragonfly.command.kick'.
+	Permission() string
+}
+
 // Command is a wrapper around a Runnable. It provides additional identity and utility methods for the actual
 // runnable command so that it may be identified more easily.
 type Command struct {
@@ -61,7 +75,10 @@ func New(name, description string, aliases []string, r ...Runnable) Command {
 		if t.Kind() == reflect.Ptr {
 			original = original.Elem()
 		}
+		// The Runnable passed is copied, so that values set in its fields, such as unexported fields holding
+		// state, are kept for every execution.
 		val := reflect.New(original.Type())
+		val.Elem().Set(original)
 		if err := verifySignature(val); err != nil {
 			panic(err.Error())
 		}
@@ -110,7 +127,7 @@ func (cmd Command) Execute(args string, source Source) {
 	defer source.SendCommandOutput(output)
 
 	var leastErroneous error
-	leastArgsLeft := len(strings.Split(args, " "))
+	leastArgsLeft := len(strings.Split(args, " ")) + 1
 
 	for _, v := range cmd.v {
 		line, err := cmd.executeRunnable(v, args, source, output)
@@ -127,9 +144,10 @@ func (cmd Command) Execute(args string, source Source) {
 			}
 			continue
 		}
-		if line.Len() <= leastArgsLeft {
-			// If the line had less (or equal) arguments left than the previous lowest, we update the error,
-			// so that we can return an error that applies for the most successful Runnable.
+		if line.Len() < leastArgsLeft {
+			// If the line had less arguments left than the previous lowest, the Runnable matched the arguments
+			// further than any Runnable before it, so we update the error to the one that applies for the most
+			// successful Runnable. If two Runnables matched equally far, the first one is kept.
 			leastErroneous = err
 			leastArgsLeft = line.Len()
 		}
@@ -155,9 +173,17 @@ func (cmd Command) Params() [][]ParamInfo {
 	for index, runnable := range cmd.v {
 		elem := runnable.Elem()
 		for i := 0; i < elem.NumField(); i++ {
+			if !elem.Field(i).CanSet() {
+				// Unexported field, which is not a parameter of the command.
+				continue
+			}
 			fieldType := elem.Type().Field(i)
+			n := name(fieldType)
+			if _, ok := elem.Field(i).Interface().(SubCommand); ok {
+				n = literal(fieldType)
+			}
 			params[index] = append(params[index], ParamInfo{
-				Name:     name(fieldType),
+				Name:     n,
 				Value:    reflect.New(elem.Field(i).Type()).Elem().Interface(),
 				Optional: optional(fieldType),
 				Suffix:   suffix(fieldType),
@@ -167,6 +193,38 @@ func (cmd Command) Params() [][]ParamInfo {
 	return params
 }
 
+// Permissions returns the permission node required to run each of the runnables of the command, in the same
+// order as Params. An empty string is returned for runnables that do not require a permission.
+func (cmd Command) Permissions() []string {
+	nodes := make([]string, len(cmd.v))
+	for i, v := range cmd.v {
+		nodes[i] = runnablePermission(v)
+	}
+	return nodes
+}
+
+// Permitted checks if the source passed has the permission to run at least one of the runnables of the
+// command.
+func (cmd Command) Permitted(source Source) bool {
+	for _, v := range cmd.v {
+		if permitted(v, source) {
+			return true
+		}
+	}
+	return false
+}
+
+// Available checks for each of the runnables of the command, in the same order as Params, if the source
+// passed is able to run it. A source is able to run a runnable if it is not limited to other sources by a
+// Limiter and if the source has the permission required to run it.
+func (cmd Command) Available(source Source) []bool {
+	available := make([]bool, len(cmd.v))
+	for i, v := range cmd.v {
+		available[i] = limited(v, source) && permitted(v, source)
+	}
+	return available
+}
+
 // String returns the usage of the command. The usage will be roughly equal to the one showed by the client
 // in-game.
 func (cmd Command) String() string {
@@ -175,22 +233,22 @@ func (cmd Command) String() string {
 
 // executeRunnable executes a Runnable v, by parsing the args passed using the source and output obtained. If
 // parsing was not successful or the Runnable could not be ran by this source, an error is returned, and the
-// leftover command line.
+// command line as it was before the argument that could not be parsed.
 func (cmd Command) executeRunnable(v reflect.Value, args string, source Source, output *Output) (*Line, error) {
-	if limiter, ok := v.Interface().(Limiter); ok {
-		allowed := false
-		for _, allowedSource := range limiter.Limit() {
-			if reflect.TypeOf(source) == reflect.TypeOf(allowedSource) {
-				allowed = true
-				break
-			}
-		}
-		if !allowed {
-			return nil, fmt.Errorf("source %T cannot execute this command", source)
-		}
+	if !limited(v, source) {
+		return nil, fmt.Errorf("source %T cannot execute this command", source)
+	}
+	if !permitted(v, source) {
+		return nil, fmt.Errorf("you do not have permission to use this command")
 	}
 
-	parser := parser{}
+	// Every execution gets its own copy of the Runnable, so that arguments parsed in one execution never end
+	// up in another.
+	template := v
+	v = reflect.New(template.Elem().Type())
+	v.Elem().Set(template.Elem())
+
+	parser := parser{source: source}
 	arguments := &Line{strings.Split(args, " ")}
 
 	// We iterate over all of the fields of the struct: Each of the fields will have an argument parsed to
@@ -203,10 +261,12 @@ func (cmd Command) executeRunnable(v reflect.Value, args string, source Source,
 			continue
 		}
 		fieldType := signature.Type().Field(i)
-		parser.currentField = fieldType.Name
+		parser.currentField, parser.currentName = fieldType.Name, name(fieldType)
+
+		before := &Line{args: arguments.args}
 		if err := parser.parseArgument(arguments, field, optional(fieldType)); err != nil {
 			// Parsing was not successful, we return immediately as we don't need to call the Runnable.
-			return arguments, err
+			return before, err
 		}
 	}
 
@@ -214,6 +274,50 @@ func (cmd Command) executeRunnable(v reflect.Value, args string, source Source,
 	return arguments, nil
 }
 
+// limited checks if the source passed is one of the sources that the Runnable v is limited to, if it
+// implements Limiter. If it does not, limited always returns true.
+func limited(v reflect.Value, source Source) bool {
+	limiter, ok := v.Interface().(Limiter)
+	if !ok {
+		return true
+	}
+	for _, allowedSource := range limiter.Limit() {
+		if reflect.TypeOf(source) == reflect.TypeOf(allowedSource) {
+			return true
+		}
+	}
+	return false
+}
+
+// permitted checks if the source passed has the permission required to run the Runnable v. Sources that do
+// not implement permission.Holder are always permitted.
+func permitted(v reflect.Value, source Source) bool {
+	node := runnablePermission(v)
+	if node == "" {
+		return true
+	}
+	if holder, ok := source.(permission.Holder); ok {
+		return holder.HasPermission(node)
+	}
+	return true
+}
+
+// runnablePermission returns the permission node required to run the Runnable v, either returned by its
+// Permission method or set in a `permission` struct tag. If it does not require a permission, an empty
+// string is returned.
+func runnablePermission(v reflect.Value) string {
+	if p, ok := v.Interface().(Permissioned); ok {
+		return p.Permission()
+	}
+	t := v.Elem().Type()
+	for i := 0; i < t.NumField(); i++ {
+		if node, ok := t.Field(i).Tag.Lookup("permission"); ok {
+			return node
+		}
+	}
+	return ""
+}
+
 // parseUsage parses the usage of a command found in value v using the name passed. It accounts for optional
 // parameters and converts types to a more friendly representation.
 func parseUsage(commandName string, v reflect.Value) string {
@@ -231,6 +335,10 @@ func parseUsage(commandName string, v reflect.Value) string {
 		typeName := getTypeName(field.Interface())
 
 		fieldType := command.Type().Field(i)
+		if _, ok := field.Interface().(SubCommand); ok {
+			parts = append(parts, literal(fieldType))
+			continue
+		}
 		suffix := suffix(fieldType)
 		if optional(fieldType) {
 			parts = append(parts, "["+name(fieldType)+": "+typeName+"]"+suffix)
@@ -255,6 +363,9 @@ func verifySignature(v reflect.Value) error {
 			continue
 		}
 		o := optional(command.Type().Field(i))
+		if _, ok := field.Interface().(SubCommand); ok && o {
+			return fmt.Errorf("sub command parameter %v must not be optional", command.Type().Field(i).Name)
+		}
 		// If the field is not optional, while the last field WAS optional, we return an error, as this is
 		// not parsable in an expected way.
 		if !o && optionalField {
@@ -279,8 +390,10 @@ func getTypeName(i interface{}) string {
 		return "string"
 	case bool:
 		return "bool"
-	case mgl64.Vec3:
+	case mgl64.Vec3, world.BlockPos:
 		return "x y z"
+	case Target:
+		return "target"
 	}
 	if param, ok := i.(Parameter); ok {
 		return param.Type()
@@ -8,11 +8,18 @@
 //
 // A Runnable may have exported fields only of the following types:
 // int8, int16, int32, int64, int, uint8, uint16, uint32, uint64, uint,
-// float32, float64, string, bool, mgl64.Vec3,
+// float32, float64, string, bool, mgl64.Vec3, world.BlockPos, cmd.Varargs, cmd.Target, cmd.SubCommand,
 // or a type that implements the cmd.Parameter or cmd.Enum interface.
 // Fields in the Runnable struct may have the `optional:""` struct tag to mark them as an optional parameter,
 // the `suffix:"$suffix"` struct tag to add a suffix to the parameter in the usage, and the `name:"name"` tag
 // to specify a name different than the field name for the parameter.
+// The coordinates of mgl64.Vec3 and world.BlockPos parameters may be absolute, relative to the position of
+// the source (~) or local to its position and rotation (^), if the source implements the Positioned interface.
+// A cmd.SubCommand field is a constant literal, such as the 'create' in '/team create <name>'. The literal
+// is the lowercase name of the field, or the name set using the `name` struct tag. Commands with multiple
+// sub commands typically pass one Runnable per sub command to cmd.New.
+// A Runnable may require a permission from the source running it by implementing the Permissioned interface
+// or by having a field, typically a blank field of type struct{}, with the `permission:"node"` struct tag.
 //
 // Commands may be registered using the cmd.Register() method. By itself, this method will not ensure that the
 // client will be able to use the command: The user of the cmd package must handle commands itself and run the
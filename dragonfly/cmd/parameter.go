@@ -1,6 +1,9 @@
 package cmd
 
-import "reflect"
+import (
+	"reflect"
+	"strings"
+)
 
 // Parameter is an interface for a generic parameters. Users may have types as command parameters that
 // implement this parameter.
@@ -27,6 +30,31 @@ type Enum interface {
 	SetOption(option string, v reflect.Value)
 }
 
+// Varargs is a parameter type that consumes all arguments left in the command line, joined by spaces. It is
+// typically the last parameter of commands that take a message, such as /say.
+type Varargs string
+
+// Parse ...
+func (Varargs) Parse(line *Line, v reflect.Value) error {
+	args := line.Leftover()
+	if len(args) == 0 {
+		return ErrInsufficientArgs
+	}
+	v.SetString(strings.Join(args, " "))
+	return nil
+}
+
+// Type ...
+func (Varargs) Type() string {
+	return "text"
+}
+
+// SubCommand is a parameter type for constant literals, such as the 'create' in '/team create <name>'. The
+// argument passed must equal the literal, which is the lowercase name of the field or the name set using the
+// `name` struct tag. SubCommand parameters are sent to the client as an enum with the literal as its only
+// option, so that every overload of a command shows up separately. A SubCommand parameter cannot be optional.
+type SubCommand struct{}
+
 // optional checks if a struct field is considered optional.
 func optional(v reflect.StructField) bool {
 	if _, ok := v.Tag.Lookup("optional"); ok {
@@ -40,6 +68,11 @@ func suffix(v reflect.StructField) string {
 	return v.Tag.Get("suffix")
 }
 
+// literal returns the literal of a SubCommand field: The lowercase name of the parameter.
+func literal(v reflect.StructField) string {
+	return strings.ToLower(name(v))
+}
+
 // name returns the name of the parameter as set in the struct tag if it exists, or the field's name if not.
 func name(v reflect.StructField) string {
 	if name, ok := v.Tag.Lookup("name"); ok {
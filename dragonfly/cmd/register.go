@@ -1,10 +1,19 @@
 package cmd
 
-import "sync"
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"sync"
+)
 
 // commands holds a list of registered commands indexed by their name.
 var commands sync.Map
 
+// playerLookup holds the function registered using RegisterPlayerLookup.
+var playerLookup struct {
+	sync.RWMutex
+	f func(name string) (world.Entity, bool)
+}
+
 // Register registers a command with its name and all aliases that it has. Any command with the same name or
 // aliases will be overwritten.
 func Register(command Command) {
@@ -33,3 +42,24 @@ func Commands() map[string]Command {
 	})
 	return cmd
 }
+
+// RegisterPlayerLookup registers a function used to look up online players by their name, regardless of the
+// world they are in. Target arguments that hold the name of a player are resolved using this function. If no
+// function is registered, names are only resolved in the world of the Source executing the command.
+func RegisterPlayerLookup(f func(name string) (world.Entity, bool)) {
+	playerLookup.Lock()
+	playerLookup.f = f
+	playerLookup.Unlock()
+}
+
+// playerByName looks up an online player by its name using the function registered using
+// RegisterPlayerLookup. If no function was registered, false is returned.
+func playerByName(name string) (world.Entity, bool) {
+	playerLookup.RLock()
+	f := playerLookup.f
+	playerLookup.RUnlock()
+	if f == nil {
+		return nil, false
+	}
+	return f(name)
+}
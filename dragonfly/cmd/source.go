@@ -1,5 +1,9 @@
 package cmd
 
+import (
+	"github.com/go-gl/mathgl/mgl64"
+)
+
 // Source represents a source of a command execution. Commands may limit the sources that can run them by
 // implementing the Limiter interface.
 type Source interface {
@@ -8,3 +12,15 @@ type Source interface {
 	// SendCommandOutput is called by a Command automatically after being run.
 	SendCommandOutput(output *Output)
 }
+
+// Positioned may be implemented by a Source that has a position and rotation, such as a player. Relative (~)
+// and local (^) coordinates passed as arguments of a command are resolved against the position and rotation
+// of the Source. Target selectors use the position as their origin.
+type Positioned interface {
+	// Position returns the current position of the Source.
+	Position() mgl64.Vec3
+	// Yaw returns the horizontal rotation of the Source in degrees.
+	Yaw() float64
+	// Pitch returns the vertical rotation of the Source in degrees.
+	Pitch() float64
+}
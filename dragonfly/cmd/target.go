@@ -0,0 +1,350 @@
+package cmd
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Target is a command parameter type that resolves to one or more entities. The argument may either be the
+// name of a player or a target selector, such as @a or @e[type=cow,r=10]. Player names are resolved in all
+// worlds using the function registered with RegisterPlayerLookup, if any.
+//
+// The following selectors are supported: @a (all players), @p (the nearest player), @r (a random player),
+// @e (all entities) and @s (the source itself). Selectors may have the arguments x, y, z (the origin of the
+// selector), r and rm (the maximum and minimum distance to the origin), dx, dy and dz (a volume starting at
+// the origin), c (the maximum amount of targets), name, m (game mode), type and tag. The name, m, type and tag
+// arguments may be negated by prefixing their value with '!'.
+//
+// Selectors are resolved against the world returned by the World() method of the Source, if it has one. The
+// position of the Source, if it implements Positioned, is used as the origin of the selector. If it does not,
+// the spawn of the world is used.
+type Target struct {
+	entities []world.Entity
+}
+
+// Entities returns the entities that the Target resolved to. A Target parsed successfully always holds at
+// least one entity.
+func (t Target) Entities() []world.Entity {
+	return t.entities
+}
+
+// target ...
+func (p parser) target(line *Line, v reflect.Value) error {
+	arg, ok := line.Next()
+	if !ok {
+		return ErrInsufficientArgs
+	}
+	w, origin := sourceWorld(p.source)
+	if !strings.HasPrefix(arg, "@") {
+		e, ok := playerByName(arg)
+		if !ok && w != nil {
+			e, ok = worldPlayerByName(w, arg)
+		}
+		if !ok {
+			return fmt.Errorf(`player "%v" not found for argument "%v"`, arg, p.currentField)
+		}
+		v.Set(reflect.ValueOf(Target{entities: []world.Entity{e}}))
+		return nil
+	}
+	if w == nil {
+		return fmt.Errorf(`cannot resolve selector "%v" for argument "%v": source is not in a world`, arg, p.currentField)
+	}
+	var entities []world.Entity
+	s, err := parseSelector(arg)
+	if err != nil {
+		return fmt.Errorf(`invalid selector "%v" for argument "%v": %w`, arg, p.currentField, err)
+	}
+	if entities = s.resolve(p.source, w, origin); len(entities) == 0 {
+		return fmt.Errorf(`no targets matched selector "%v" for argument "%v"`, arg, p.currentField)
+	}
+	v.Set(reflect.ValueOf(Target{entities: entities}))
+	return nil
+}
+
+// worldPlayerByName looks for a player with the name passed in the world passed. The name is matched case
+// insensitively.
+func worldPlayerByName(w *world.World, name string) (world.Entity, bool) {
+	for _, e := range w.Entities() {
+		if isPlayer(e) && strings.EqualFold(e.(named).Name(), name) {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// named is implemented by entities that have a name, such as players.
+type named interface {
+	Name() string
+}
+
+// isPlayer checks if an entity is a player. Players are the only entities that both have a name and are able
+// to execute commands.
+func isPlayer(e world.Entity) bool {
+	_, isNamed := e.(named)
+	_, isSource := e.(Source)
+	return isNamed && isSource
+}
+
+// sourceWorld returns the world of the Source passed and the origin used for selectors executed by it. If the
+// Source is not in a world, the world returned is nil.
+func sourceWorld(source Source) (*world.World, mgl64.Vec3) {
+	ws, ok := source.(interface{ World() *world.World })
+	if !ok || ws.World() == nil {
+		return nil, mgl64.Vec3{}
+	}
+	w := ws.World()
+	if pos, ok := source.(Positioned); ok {
+		return w, pos.Position()
+	}
+	return w, w.Spawn().Vec3Centre()
+}
+
+// selector is a parsed target selector, such as @e[type=cow,r=10].
+type selector struct {
+	kind byte
+	args map[string][]string
+}
+
+// parseSelector parses a target selector from the argument passed.
+func parseSelector(arg string) (selector, error) {
+	if len(arg) < 2 {
+		return selector{}, fmt.Errorf("missing selector type")
+	}
+	s := selector{kind: arg[1], args: map[string][]string{}}
+	switch s.kind {
+	case 'a', 'p', 'r', 'e', 's':
+	default:
+		return s, fmt.Errorf("unknown selector type @%c", s.kind)
+	}
+	rest := arg[2:]
+	if rest == "" {
+		return s, nil
+	}
+	if !strings.HasPrefix(rest, "[") || !strings.HasSuffix(rest, "]") {
+		return s, fmt.Errorf("selector arguments must be enclosed in brackets")
+	}
+	rest = strings.TrimSpace(rest[1 : len(rest)-1])
+	if rest == "" {
+		return s, nil
+	}
+	for _, pair := range strings.Split(rest, ",") {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 {
+			return s, fmt.Errorf("selector argument %v has no value", pair)
+		}
+		key, value := strings.TrimSpace(kv[0]), strings.TrimSpace(kv[1])
+		switch key {
+		case "x", "y", "z", "r", "rm", "dx", "dy", "dz":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return s, fmt.Errorf("selector argument %v must be a number", key)
+			}
+		case "c":
+			if _, err := strconv.Atoi(value); err != nil {
+				return s, fmt.Errorf("selector argument c must be an integer")
+			}
+		case "m":
+			if _, ok := parseGameMode(strings.TrimPrefix(value, "!")); !ok {
+				return s, fmt.Errorf("unknown game mode %v", value)
+			}
+		case "name", "type", "tag":
+		default:
+			return s, fmt.Errorf("unknown selector argument %v", key)
+		}
+		if len(s.args[key]) != 0 && !strings.HasPrefix(value, "!") && key != "tag" {
+			// Only negated values may be passed multiple times, with the exception of tags, of which all must
+			// be present.
+			return s, fmt.Errorf("selector argument %v may only be set once", key)
+		}
+		s.args[key] = append(s.args[key], value)
+	}
+	return s, nil
+}
+
+// resolve resolves the selector to the entities it targets in the world passed, using the origin passed
+// unless overwritten by the x, y and z arguments.
+func (s selector) resolve(source Source, w *world.World, origin mgl64.Vec3) []world.Entity {
+	for i, key := range [...]string{"x", "y", "z"} {
+		if _, ok := s.args[key]; ok {
+			origin[i] = s.float(key)
+		}
+	}
+	var candidates []world.Entity
+	if s.kind == 's' {
+		if e, ok := source.(world.Entity); ok && e.World() == w {
+			candidates = append(candidates, e)
+		}
+	} else {
+		candidates = w.Entities()
+	}
+
+	entities := make([]world.Entity, 0, len(candidates))
+	for _, e := range candidates {
+		if (s.kind == 'a' || s.kind == 'p' || s.kind == 'r') && !isPlayer(e) {
+			continue
+		}
+		if s.matches(e, origin) {
+			entities = append(entities, e)
+		}
+	}
+
+	count, hasCount := 0, false
+	if _, ok := s.args["c"]; ok {
+		count, hasCount = int(s.float("c")), true
+	}
+	switch s.kind {
+	case 'p':
+		if !hasCount {
+			count, hasCount = 1, true
+		}
+	case 'r':
+		rand.Shuffle(len(entities), func(i, j int) {
+			entities[i], entities[j] = entities[j], entities[i]
+		})
+		if !hasCount {
+			count, hasCount = 1, true
+		}
+	}
+	if !hasCount {
+		return entities
+	}
+	if s.kind != 'r' {
+		// Limited selectors select the entities nearest to the origin, or the furthest if the count is
+		// negative.
+		sort.SliceStable(entities, func(i, j int) bool {
+			di, dj := entities[i].Position().Sub(origin).Len(), entities[j].Position().Sub(origin).Len()
+			if count < 0 {
+				return di > dj
+			}
+			return di < dj
+		})
+	}
+	if count < 0 {
+		count = -count
+	}
+	if len(entities) > count {
+		entities = entities[:count]
+	}
+	return entities
+}
+
+// matches checks if the entity passed matches all arguments of the selector.
+func (s selector) matches(e world.Entity, origin mgl64.Vec3) bool {
+	dist := e.Position().Sub(origin).Len()
+	if _, ok := s.args["r"]; ok && dist > s.float("r") {
+		return false
+	}
+	if _, ok := s.args["rm"]; ok && dist < s.float("rm") {
+		return false
+	}
+	_, dx := s.args["dx"]
+	_, dy := s.args["dy"]
+	_, dz := s.args["dz"]
+	if dx || dy || dz {
+		// The volume spans from the origin to the origin plus the delta passed. Like the vanilla volume, it
+		// always includes the blocks at both ends.
+		a, b := origin, origin.Add(mgl64.Vec3{s.float("dx"), s.float("dy"), s.float("dz")})
+		min := mgl64.Vec3{math.Floor(math.Min(a[0], b[0])), math.Floor(math.Min(a[1], b[1])), math.Floor(math.Min(a[2], b[2]))}
+		max := mgl64.Vec3{math.Floor(math.Max(a[0], b[0])) + 1, math.Floor(math.Max(a[1], b[1])) + 1, math.Floor(math.Max(a[2], b[2])) + 1}
+		if !e.AABB().Translate(e.Position()).IntersectsWith(physics.NewAABB(min, max)) {
+			return false
+		}
+	}
+	if !s.matchAll("name", func(value string) bool {
+		n, ok := e.(named)
+		return ok && n.Name() == value
+	}) {
+		return false
+	}
+	if !s.matchAll("m", func(value string) bool {
+		g, ok := e.(interface{ GameMode() gamemode.GameMode })
+		mode, _ := parseGameMode(value)
+		return ok && g.GameMode() == mode
+	}) {
+		return false
+	}
+	if !s.matchAll("type", func(value string) bool {
+		if !strings.Contains(value, ":") {
+			value = "minecraft:" + value
+		}
+		return entityType(e) == value
+	}) {
+		return false
+	}
+	return s.matchAll("tag", func(value string) bool {
+		var tags []string
+		if t, ok := e.(interface{ Tags() []string }); ok {
+			tags = t.Tags()
+		}
+		if value == "" {
+			// An empty tag matches only entities without any tags.
+			return len(tags) == 0
+		}
+		for _, tag := range tags {
+			if tag == value {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// matchAll checks if all values of the argument with the key passed match using the function passed. Values
+// prefixed with '!' must not match.
+func (s selector) matchAll(key string, f func(value string) bool) bool {
+	for _, value := range s.args[key] {
+		if strings.HasPrefix(value, "!") {
+			if f(value[1:]) {
+				return false
+			}
+			continue
+		}
+		if !f(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// float returns the value of the numerical argument with the key passed, or 0 if it was not set.
+func (s selector) float(key string) float64 {
+	if values := s.args[key]; len(values) != 0 {
+		f, _ := strconv.ParseFloat(values[0], 64)
+		return f
+	}
+	return 0
+}
+
+// entityType returns the type of the entity passed, such as 'minecraft:cow'.
+func entityType(e world.Entity) string {
+	if isPlayer(e) {
+		return "minecraft:player"
+	}
+	if s, ok := e.(interface{ EncodeEntity() string }); ok {
+		return s.EncodeEntity()
+	}
+	return ""
+}
+
+// parseGameMode parses a game mode from its name, abbreviation or ID.
+func parseGameMode(s string) (gamemode.GameMode, bool) {
+	switch strings.ToLower(s) {
+	case "survival", "s", "0":
+		return gamemode.Survival{}, true
+	case "creative", "c", "1":
+		return gamemode.Creative{}, true
+	case "adventure", "a", "2":
+		return gamemode.Adventure{}, true
+	case "spectator", "sp", "3":
+		return gamemode.Spectator{}, true
+	}
+	return nil, false
+}
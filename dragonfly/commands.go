@@ -0,0 +1,603 @@
+package dragonfly
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/cmd"
+	"github.com/df-mc/dragonfly/dragonfly/entity"
+	"github.com/df-mc/dragonfly/dragonfly/entity/effect"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/player"
+	"github.com/df-mc/dragonfly/dragonfly/player/chat"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/go-gl/mathgl/mgl64"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// registerCommands registers the default commands of the server, such as /stop and /gamemode. The commands
+// registered hold the server passed, so that they are able to look up players and worlds.
+func (server *Server) registerCommands() {
+	cmd.RegisterPlayerLookup(func(name string) (world.Entity, bool) {
+		if p, ok := server.PlayerByName(name); ok {
+			return p, true
+		}
+		return nil, false
+	})
+	cmd.Register(cmd.New("stop", "Stops the server.", nil, stopCommand{s: server}))
+	cmd.Register(cmd.New("list", "Lists the players online.", nil, listCommand{s: server}))
+	cmd.Register(cmd.New("kick", "Kicks a player from the server.", nil, kickCommand{}))
+	cmd.Register(cmd.New("say", "Broadcasts a message to all players.", nil, sayCommand{}))
+	cmd.Register(cmd.New("tp", "Teleports players to a position or another player.", []string{"teleport"},
+		teleportPlayerToPosCommand{},
+		teleportToPosCommand{},
+		teleportPlayerToPlayerCommand{},
+		teleportToPlayerCommand{},
+	))
+	cmd.Register(cmd.New("gamemode", "Changes the game mode of a player.", []string{"gm"},
+		gameModePlayerCommand{},
+		gameModeCommand{},
+	))
+	cmd.Register(cmd.New("give", "Gives an item to a player.", nil, giveCommand{}))
+	cmd.Register(cmd.New("time", "Changes the time of the world.", nil,
+		timeSetCommand{s: server},
+		timeSetNamedCommand{s: server},
+	))
+	cmd.Register(cmd.New("difficulty", "Changes the difficulty of the world.", nil, difficultyCommand{s: server}))
+	cmd.Register(cmd.New("effect", "Adds or removes effects of a player.", nil,
+		effectClearCommand{},
+		effectCommand{},
+	))
+	cmd.Register(cmd.New("setworldspawn", "Sets the spawn position of the world.", nil,
+		setWorldSpawnPosCommand{s: server},
+		setWorldSpawnCommand{},
+	))
+}
+
+// stopCommand implements the /stop command.
+type stopCommand struct {
+	_ struct{} `permission:"dragonfly.command.stop"`
+	s *Server
+}
+
+// Run ...
+func (c stopCommand) Run(_ cmd.Source, output *cmd.Output) {
+	output.Print("Stopping the server...")
+	// The server is closed in a different goroutine, as closing it disconnects the players, which might
+	// include the source of the command.
+	go func() {
+		_ = c.s.Close()
+	}()
+}
+
+// listCommand implements the /list command.
+type listCommand struct {
+	_ struct{} `permission:"dragonfly.command.list"`
+	s *Server
+}
+
+// Run ...
+func (c listCommand) Run(_ cmd.Source, output *cmd.Output) {
+	players := c.s.Players()
+	names := make([]string, 0, len(players))
+	for _, p := range players {
+		names = append(names, p.Name())
+	}
+	sort.Strings(names)
+	output.Printf("There are %v/%v players online: %v", len(names), c.s.MaxPlayerCount(), strings.Join(names, ", "))
+}
+
+// kickCommand implements the /kick command.
+type kickCommand struct {
+	_       struct{} `permission:"dragonfly.command.kick"`
+	Targets cmd.Target
+	Reason  cmd.Varargs `optional:""`
+}
+
+// Run ...
+func (c kickCommand) Run(_ cmd.Source, output *cmd.Output) {
+	reason := string(c.Reason)
+	if reason == "" {
+		reason = "Kicked by an operator."
+	}
+	for _, p := range targetPlayers(c.Targets, output) {
+		p.Disconnect(reason)
+		output.Printf("Kicked %v: %v", p.Name(), reason)
+	}
+}
+
+// sayCommand implements the /say command.
+type sayCommand struct {
+	_       struct{} `permission:"dragonfly.command.say"`
+	Message cmd.Varargs
+}
+
+// Run ...
+func (c sayCommand) Run(source cmd.Source, _ *cmd.Output) {
+	chat.Global.Printf("[%v] %v\n", sourceName(source), c.Message)
+}
+
+// teleportPlayerToPosCommand implements the /tp <player> <destination> overload.
+type teleportPlayerToPosCommand struct {
+	_           struct{} `permission:"dragonfly.command.tp"`
+	Targets     cmd.Target
+	Destination mgl64.Vec3
+}
+
+// Run ...
+func (c teleportPlayerToPosCommand) Run(_ cmd.Source, output *cmd.Output) {
+	for _, p := range targetPlayers(c.Targets, output) {
+		p.Teleport(c.Destination)
+		output.Printf("Teleported %v to %v", p.Name(), c.Destination)
+	}
+}
+
+// teleportToPosCommand implements the /tp <destination> overload.
+type teleportToPosCommand struct {
+	_           struct{} `permission:"dragonfly.command.tp"`
+	Destination mgl64.Vec3
+}
+
+// Run ...
+func (c teleportToPosCommand) Run(source cmd.Source, output *cmd.Output) {
+	p := source.(*player.Player)
+	p.Teleport(c.Destination)
+	output.Printf("Teleported %v to %v", p.Name(), c.Destination)
+}
+
+// Limit ...
+func (teleportToPosCommand) Limit() []cmd.Source {
+	return []cmd.Source{&player.Player{}}
+}
+
+// teleportPlayerToPlayerCommand implements the /tp <player> <destination> overload with a player as the
+// destination.
+type teleportPlayerToPlayerCommand struct {
+	_           struct{} `permission:"dragonfly.command.tp"`
+	Targets     cmd.Target
+	Destination cmd.Target
+}
+
+// Run ...
+func (c teleportPlayerToPlayerCommand) Run(_ cmd.Source, output *cmd.Output) {
+	teleportToTarget(targetPlayers(c.Targets, output), c.Destination, output)
+}
+
+// teleportToPlayerCommand implements the /tp <destination> overload with a player as the destination.
+type teleportToPlayerCommand struct {
+	_           struct{} `permission:"dragonfly.command.tp"`
+	Destination cmd.Target
+}
+
+// Run ...
+func (c teleportToPlayerCommand) Run(source cmd.Source, output *cmd.Output) {
+	teleportToTarget([]*player.Player{source.(*player.Player)}, c.Destination, output)
+}
+
+// Limit ...
+func (teleportToPlayerCommand) Limit() []cmd.Source {
+	return []cmd.Source{&player.Player{}}
+}
+
+// teleportToTarget teleports the players passed to the entity targeted by the destination passed. The
+// destination must resolve to exactly one entity. Players in a different world than the destination cannot
+// be teleported.
+func teleportToTarget(players []*player.Player, destination cmd.Target, output *cmd.Output) {
+	if len(destination.Entities()) != 1 {
+		output.Errorf("destination must be a single entity, but %v entities matched", len(destination.Entities()))
+		return
+	}
+	dest := destination.Entities()[0]
+	for _, p := range players {
+		if dest.World() != p.World() {
+			output.Errorf("%v is in a different world than the destination", p.Name())
+			continue
+		}
+		p.Teleport(dest.Position())
+		output.Printf("Teleported %v to %v", p.Name(), dest.Position())
+	}
+}
+
+// gameModePlayerCommand implements the /gamemode <mode> <player> overload.
+type gameModePlayerCommand struct {
+	_       struct{} `permission:"dragonfly.command.gamemode"`
+	Mode    gameMode
+	Targets cmd.Target
+}
+
+// Run ...
+func (c gameModePlayerCommand) Run(_ cmd.Source, output *cmd.Output) {
+	for _, p := range targetPlayers(c.Targets, output) {
+		p.SetGameMode(c.Mode.GameMode())
+		output.Printf("Set the game mode of %v to %v", p.Name(), c.Mode.name())
+	}
+}
+
+// gameModeCommand implements the /gamemode <mode> overload.
+type gameModeCommand struct {
+	_    struct{} `permission:"dragonfly.command.gamemode"`
+	Mode gameMode
+}
+
+// Run ...
+func (c gameModeCommand) Run(source cmd.Source, output *cmd.Output) {
+	p := source.(*player.Player)
+	p.SetGameMode(c.Mode.GameMode())
+	output.Printf("Set own game mode to %v", c.Mode.name())
+}
+
+// Limit ...
+func (gameModeCommand) Limit() []cmd.Source {
+	return []cmd.Source{&player.Player{}}
+}
+
+// giveCommand implements the /give command.
+type giveCommand struct {
+	_       struct{} `permission:"dragonfly.command.give"`
+	Targets cmd.Target
+	Item    itemName
+	Amount  int `optional:""`
+}
+
+// Run ...
+func (c giveCommand) Run(_ cmd.Source, output *cmd.Output) {
+	it, ok := world_itemByName("minecraft:"+string(c.Item), 0)
+	if !ok {
+		output.Errorf("unknown item %v", c.Item)
+		return
+	}
+	if c.Amount == 0 {
+		c.Amount = 1
+	}
+	if c.Amount < 0 {
+		output.Errorf("amount must not be negative")
+		return
+	}
+	for _, p := range targetPlayers(c.Targets, output) {
+		n, _ := p.Inventory().AddItem(item.NewStack(it, c.Amount))
+		if n != c.Amount {
+			output.Printf("Gave %v %v to %v (%v did not fit in the inventory)", n, c.Item, p.Name(), c.Amount-n)
+			continue
+		}
+		output.Printf("Gave %v %v to %v", c.Amount, c.Item, p.Name())
+	}
+}
+
+// timeSetCommand implements the /time set <time> overload.
+type timeSetCommand struct {
+	_    struct{} `permission:"dragonfly.command.time"`
+	s    *Server
+	Set  cmd.SubCommand
+	Time int
+}
+
+// Run ...
+func (c timeSetCommand) Run(source cmd.Source, output *cmd.Output) {
+	sourceWorld(c.s, source).SetTime(c.Time)
+	output.Printf("Set the time to %v", c.Time)
+}
+
+// timeSetNamedCommand implements the /time set <day|night|...> overload.
+type timeSetNamedCommand struct {
+	_    struct{} `permission:"dragonfly.command.time"`
+	s    *Server
+	Set  cmd.SubCommand
+	Time timeName
+}
+
+// Run ...
+func (c timeSetNamedCommand) Run(source cmd.Source, output *cmd.Output) {
+	t := timeNames[string(c.Time)]
+	sourceWorld(c.s, source).SetTime(t)
+	output.Printf("Set the time to %v", t)
+}
+
+// difficultyCommand implements the /difficulty command.
+type difficultyCommand struct {
+	_          struct{} `permission:"dragonfly.command.difficulty"`
+	s          *Server
+	Difficulty difficultyName
+}
+
+// Run ...
+func (c difficultyCommand) Run(source cmd.Source, output *cmd.Output) {
+	sourceWorld(c.s, source).SetDifficulty(c.Difficulty.Difficulty())
+	output.Printf("Set the difficulty to %v", c.Difficulty.name())
+}
+
+// effectClearCommand implements the /effect <player> clear overload.
+type effectClearCommand struct {
+	_       struct{} `permission:"dragonfly.command.effect"`
+	Targets cmd.Target
+	Clear   cmd.SubCommand
+}
+
+// Run ...
+func (c effectClearCommand) Run(_ cmd.Source, output *cmd.Output) {
+	for _, p := range targetPlayers(c.Targets, output) {
+		for _, e := range p.Effects() {
+			p.RemoveEffect(e)
+		}
+		output.Printf("Removed all effects of %v", p.Name())
+	}
+}
+
+// effectCommand implements the /effect <player> <effect> [seconds] [amplifier] [hideParticles] overload.
+type effectCommand struct {
+	_             struct{} `permission:"dragonfly.command.effect"`
+	Targets       cmd.Target
+	Effect        effectName
+	Seconds       int  `optional:""`
+	Amplifier     int  `optional:""`
+	HideParticles bool `optional:""`
+}
+
+// Run ...
+func (c effectCommand) Run(_ cmd.Source, output *cmd.Output) {
+	if c.Seconds < 0 || c.Amplifier < 0 || c.Amplifier > 255 {
+		output.Errorf("seconds and amplifier must be positive and the amplifier must not exceed 255")
+		return
+	}
+	if c.Seconds == 0 {
+		c.Seconds = 30
+	}
+	e := effect.New(effects[string(c.Effect)], c.Amplifier+1, time.Duration(c.Seconds)*time.Second, false, c.HideParticles)
+	for _, p := range targetPlayers(c.Targets, output) {
+		p.AddEffect(e)
+		output.Printf("Gave %v %v to %v for %v seconds", c.Effect, c.Amplifier+1, p.Name(), c.Seconds)
+	}
+}
+
+// setWorldSpawnPosCommand implements the /setworldspawn <position> overload.
+type setWorldSpawnPosCommand struct {
+	_        struct{} `permission:"dragonfly.command.setworldspawn"`
+	s        *Server
+	Position world.BlockPos
+}
+
+// Run ...
+func (c setWorldSpawnPosCommand) Run(source cmd.Source, output *cmd.Output) {
+	sourceWorld(c.s, source).SetSpawn(c.Position)
+	output.Printf("Set the world spawn to %v", c.Position)
+}
+
+// setWorldSpawnCommand implements the /setworldspawn overload, which sets the spawn to the position of the
+// player running it.
+type setWorldSpawnCommand struct {
+	_ struct{} `permission:"dragonfly.command.setworldspawn"`
+}
+
+// Run ...
+func (c setWorldSpawnCommand) Run(source cmd.Source, output *cmd.Output) {
+	p := source.(*player.Player)
+	pos := world.BlockPosFromVec3(p.Position())
+	p.World().SetSpawn(pos)
+	output.Printf("Set the world spawn to %v", pos)
+}
+
+// Limit ...
+func (setWorldSpawnCommand) Limit() []cmd.Source {
+	return []cmd.Source{&player.Player{}}
+}
+
+// sourceWorld returns the world of the source passed if it is a player, or the default world of the server if
+// it is not.
+func sourceWorld(server *Server, source cmd.Source) *world.World {
+	if p, ok := source.(*player.Player); ok {
+		if w := p.World(); w != nil {
+			return w
+		}
+	}
+	return server.World()
+}
+
+// sourceName returns the name of the source passed, or 'Server' if the source does not have a name.
+func sourceName(source cmd.Source) string {
+	if n, ok := source.(interface{ Name() string }); ok {
+		return n.Name()
+	}
+	return "Server"
+}
+
+// targetPlayers returns the players among the entities targeted. If none of the entities is a player, an
+// error is added to the output.
+func targetPlayers(t cmd.Target, output *cmd.Output) []*player.Player {
+	players := make([]*player.Player, 0, len(t.Entities()))
+	for _, e := range t.Entities() {
+		if p, ok := e.(*player.Player); ok {
+			players = append(players, p)
+		}
+	}
+	if len(players) == 0 {
+		output.Errorf("no players were targeted")
+	}
+	return players
+}
+
+// gameMode is an enum parameter for the game modes of a player.
+type gameMode string
+
+// Type ...
+func (gameMode) Type() string {
+	return "GameMode"
+}
+
+// Options ...
+func (gameMode) Options() []string {
+	return []string{"survival", "creative", "adventure", "spectator", "s", "c", "a", "sp", "0", "1", "2", "3"}
+}
+
+// SetOption ...
+func (gameMode) SetOption(option string, v reflect.Value) {
+	v.SetString(option)
+}
+
+// GameMode returns the gamemode.GameMode that the option selected represents.
+func (g gameMode) GameMode() gamemode.GameMode {
+	switch g {
+	case "creative", "c", "1":
+		return gamemode.Creative{}
+	case "adventure", "a", "2":
+		return gamemode.Adventure{}
+	case "spectator", "sp", "3":
+		return gamemode.Spectator{}
+	}
+	return gamemode.Survival{}
+}
+
+// name returns the full name of the game mode selected.
+func (g gameMode) name() string {
+	return strings.ToLower(reflect.TypeOf(g.GameMode()).Name())
+}
+
+// difficultyName is an enum parameter for the difficulties of a world.
+type difficultyName string
+
+// Type ...
+func (difficultyName) Type() string {
+	return "Difficulty"
+}
+
+// Options ...
+func (difficultyName) Options() []string {
+	return []string{"peaceful", "easy", "normal", "hard", "p", "e", "n", "h", "0", "1", "2", "3"}
+}
+
+// SetOption ...
+func (difficultyName) SetOption(option string, v reflect.Value) {
+	v.SetString(option)
+}
+
+// Difficulty returns the difficulty.Difficulty that the option selected represents.
+func (d difficultyName) Difficulty() difficulty.Difficulty {
+	switch d {
+	case "peaceful", "p", "0":
+		return difficulty.Peaceful{}
+	case "easy", "e", "1":
+		return difficulty.Easy{}
+	case "hard", "h", "3":
+		return difficulty.Hard{}
+	}
+	return difficulty.Normal{}
+}
+
+// name returns the full name of the difficulty selected.
+func (d difficultyName) name() string {
+	return strings.ToLower(reflect.TypeOf(d.Difficulty()).Name())
+}
+
+// timeNames holds the times of the day that may be set using their name, indexed by that name.
+var timeNames = map[string]int{
+	"day":      1000,
+	"noon":     6000,
+	"sunset":   12000,
+	"night":    13000,
+	"midnight": 18000,
+	"sunrise":  23000,
+}
+
+// timeName is an enum parameter for the named times of the day, such as 'day' and 'night'.
+type timeName string
+
+// Type ...
+func (timeName) Type() string {
+	return "TimeSpec"
+}
+
+// Options ...
+func (timeName) Options() []string {
+	return []string{"day", "noon", "sunset", "night", "midnight", "sunrise"}
+}
+
+// SetOption ...
+func (timeName) SetOption(option string, v reflect.Value) {
+	v.SetString(option)
+}
+
+// effects holds all effects that may be added using /effect, indexed by their name.
+var effects = map[string]entity.Effect{
+	"speed":           effect.Speed{},
+	"slowness":        effect.Slowness{},
+	"haste":           effect.Haste{},
+	"mining_fatigue":  effect.MiningFatigue{},
+	"strength":        effect.Strength{},
+	"instant_health":  effect.InstantHealth{},
+	"instant_damage":  effect.InstantDamage{},
+	"jump_boost":      effect.JumpBoost{},
+	"nausea":          effect.Nausea{},
+	"regeneration":    effect.Regeneration{},
+	"resistance":      effect.Resistance{},
+	"fire_resistance": effect.FireResistance{},
+	"water_breathing": effect.WaterBreathing{},
+	"invisibility":    effect.Invisibility{},
+	"blindness":       effect.Blindness{},
+	"night_vision":    effect.NightVision{},
+	"hunger":          effect.Hunger{},
+	"weakness":        effect.Weakness{},
+	"poison":          effect.Poison{},
+	"wither":          effect.Wither{},
+	"health_boost":    effect.HealthBoost{},
+	"absorption":      effect.Absorption{},
+	"saturation":      effect.Saturation{},
+	"levitation":      effect.Levitation{},
+	"fatal_poison":    effect.FatalPoison{},
+	"conduit_power":   effect.ConduitPower{},
+	"slow_falling":    effect.SlowFalling{},
+}
+
+// effectName is an enum parameter for the names of the effects in the effects map.
+type effectName string
+
+// Type ...
+func (effectName) Type() string {
+	return "Effect"
+}
+
+// Options ...
+func (effectName) Options() []string {
+	names := make([]string, 0, len(effects))
+	for name := range effects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetOption ...
+func (effectName) SetOption(option string, v reflect.Value) {
+	v.SetString(option)
+}
+
+// itemName is an enum parameter for the names of all registered items, without the 'minecraft:' prefix.
+type itemName string
+
+// Type ...
+func (itemName) Type() string {
+	return "Item"
+}
+
+// Options ...
+func (itemName) Options() []string {
+	names := world_itemNames()
+	for i, name := range names {
+		names[i] = strings.TrimPrefix(name, "minecraft:")
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SetOption ...
+func (itemName) SetOption(option string, v reflect.Value) {
+	v.SetString(option)
+}
+
+//go:linkname world_itemByName github.com/df-mc/dragonfly/dragonfly/world.itemByName
+//noinspection ALL
+func world_itemByName(name string, meta int16) (world.Item, bool)
+
+//go:linkname world_itemNames github.com/df-mc/dragonfly/dragonfly/world.itemNames
+//noinspection ALL
+func world_itemNames() []string
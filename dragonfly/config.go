@@ -25,6 +25,12 @@ type Config struct {
 		Name string
 		// Folder is the folder that the data of the world resides in.
 		Folder string
+		// Generator is the name of the generator used to generate new chunks of the world. It is either
+		// 'flat' for a superflat world or 'overworld' for terrain similar to that of the vanilla overworld.
+		Generator string
+		// Seed is the seed used by the generator to generate terrain. The same seed always results in the
+		// same terrain being generated.
+		Seed int64
 		// MaximumChunkRadius is the maximum chunk radius that players may set in their settings. If they try
 		// to set it above this number, it will be capped and set to the max.
 		MaximumChunkRadius int
@@ -32,6 +38,22 @@ type Config struct {
 		// it to receive random ticks. This field may be set to 0 to disable random block updates altogether.
 		SimulationDistance int
 	}
+	Players struct {
+		// SaveData specifies if the data of players, such as their inventory, position and health, should be
+		// saved when they leave the server and restored when they join again.
+		SaveData bool
+		// Folder is the folder that the data of players is saved in.
+		Folder string
+		// MovementTolerance is the distance in blocks that the movement of a player may deviate from the
+		// movement simulated by the server per tick. If the movement of a player deviates more, the player is
+		// moved back to its previous position. Movement is not validated if MovementTolerance is negative.
+		MovementTolerance float64
+	}
+	Permissions struct {
+		// File is the JSON file that permission groups and the permissions of players are stored in. If
+		// empty, permissions are not saved and are lost when the server stops.
+		File string
+	}
 }
 
 // DefaultConfig returns a configuration with the default values filled out.
@@ -42,7 +64,12 @@ func DefaultConfig() Config {
 	c.Server.ShutdownMessage = "Server closed."
 	c.World.Name = "World"
 	c.World.Folder = "world"
+	c.World.Generator = "flat"
 	c.World.MaximumChunkRadius = 32
 	c.World.SimulationDistance = 8
+	c.Players.SaveData = true
+	c.Players.Folder = "players"
+	c.Players.MovementTolerance = 0.1
+	c.Permissions.File = "permissions.json"
 	return c
 }
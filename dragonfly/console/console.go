@@ -0,0 +1,79 @@
+// Package console implements a command source that reads commands from an io.Reader, such as the standard
+// input of the server, and logs the output of the commands executed.
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/cmd"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/sirupsen/logrus"
+	"io"
+	"strings"
+)
+
+// Console is a cmd.Source that executes commands read from an io.Reader, typically os.Stdin. The output of
+// the commands executed is logged using a logrus.Logger. Unlike players, the console may always run every
+// command that it is not limited from running, regardless of permissions.
+type Console struct {
+	log *logrus.Logger
+	w   *world.World
+}
+
+// Compile time check to make sure Console implements cmd.Source.
+var _ cmd.Source = (*Console)(nil)
+
+// New returns a new Console that logs the output of the commands it executes to the logrus.Logger passed.
+// Arguments of commands that depend on a world, such as target selectors, are resolved against the world
+// passed, which is typically the default world of the server. The world may be nil.
+func New(log *logrus.Logger, w *world.World) *Console {
+	return &Console{log: log, w: w}
+}
+
+// Run reads command lines from the io.Reader passed and executes them one by one. Run blocks until the
+// reader returns io.EOF, in which case nil is returned, or until another error occurs, which is returned.
+func (c *Console) Run(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		c.Execute(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("error reading console input: %w", err)
+	}
+	return nil
+}
+
+// Execute executes the command line passed, such as 'kick Steve'. The leading slash of the command may be
+// omitted. Empty lines are ignored.
+func (c *Console) Execute(commandLine string) {
+	args := strings.Fields(strings.TrimPrefix(strings.TrimSpace(commandLine), "/"))
+	if len(args) == 0 {
+		return
+	}
+	command, ok := cmd.ByAlias(args[0])
+	if !ok {
+		c.log.Errorf("Unknown command '%v'", args[0])
+		return
+	}
+	command.Execute(strings.Join(args[1:], " "), c)
+}
+
+// Name returns the name of the console: 'Console'.
+func (c *Console) Name() string {
+	return "Console"
+}
+
+// World returns the world that the console executes commands in, as passed to New.
+func (c *Console) World() *world.World {
+	return c.w
+}
+
+// SendCommandOutput logs the messages of the output passed as info and its errors as errors.
+func (c *Console) SendCommandOutput(output *cmd.Output) {
+	for _, message := range output.Messages() {
+		c.log.Info(message)
+	}
+	for _, err := range output.Errors() {
+		c.log.Error(err)
+	}
+}
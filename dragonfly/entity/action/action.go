@@ -17,6 +17,9 @@ type Hurt struct{ action }
 // watching it.
 type Death struct{ action }
 
+// WakeUp makes a sleeping entity display the animation of waking up and leaving its bed.
+type WakeUp struct{ action }
+
 // PickedUp makes an item get picked up by a collector. After this animation, the item disappears from viewers
 // watching it.
 type PickedUp struct {
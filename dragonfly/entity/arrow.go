@@ -0,0 +1,272 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/action"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"go.uber.org/atomic"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Arrow is a projectile shot by bows. The damage dealt by an arrow depends on the speed that it hits an entity
+// with. Arrows that hit a block get stuck in it, after which they may be picked up.
+type Arrow struct {
+	pos, velocity atomic.Value
+	yaw, pitch    atomic.Float64
+	owner         world.Entity
+
+	baseDamage float64
+	critical   bool
+	punch      int
+	pickup     bool
+	fireTicks  atomic.Int64
+
+	// collided is true if the arrow is stuck in the block at collidedPos. groundTicks is the amount of ticks
+	// that the arrow has been stuck in a block.
+	collided    bool
+	collidedPos world.BlockPos
+	groundTicks int
+
+	c *projectileComputer
+}
+
+// NewArrow creates a new arrow at the position passed, moving with the velocity passed. The owner is the
+// entity that shot the arrow, or nil if it was not shot by an entity. The arrow deals a base damage of 2 and
+// may be picked up once it lands.
+func NewArrow(pos, velocity mgl64.Vec3, owner world.Entity) *Arrow {
+	a := &Arrow{owner: owner, baseDamage: 2, pickup: true, c: newProjectileComputer(0.05, 0.01)}
+	a.pos.Store(pos)
+	a.velocity.Store(velocity)
+	a.yaw.Store(0)
+	a.pitch.Store(0)
+	if !velocity.ApproxEqual(mgl64.Vec3{}) {
+		yaw, pitch := rotationTowards(pos, pos.Add(velocity))
+		a.yaw.Store(yaw)
+		a.pitch.Store(pitch)
+	}
+	return a
+}
+
+// newArrow creates an arrow shot by the owner passed with the speed passed. It is used by the item package to
+// shoot arrows from bows without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newArrow(owner world.Entity, speed, damage float64, critical bool, punch int, flame, pickup bool) world.Entity {
+	a := NewArrow(EyePosition(owner), DirectionVector(owner).Mul(speed), owner)
+	a.baseDamage, a.critical, a.punch, a.pickup = damage, critical, punch, pickup
+	if flame {
+		a.SetOnFire(time.Second * 100)
+	}
+	return a
+}
+
+// Owner returns the entity that shot the arrow.
+func (a *Arrow) Owner() world.Entity {
+	return a.owner
+}
+
+// Position returns the current position of the arrow.
+func (a *Arrow) Position() mgl64.Vec3 {
+	return a.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the arrow is currently in, or nil if it is not added to a world.
+func (a *Arrow) World() *world.World {
+	w, _ := world.OfEntity(a)
+	return w
+}
+
+// Tick ticks the arrow, moving it and checking if it hit a block or an entity. Arrows that are stuck in a
+// block may be picked up by nearby collectors.
+func (a *Arrow) Tick(current int64) {
+	a.tickFire()
+	if a.collided {
+		a.tickCollided()
+		return
+	}
+	pos, hit := a.c.tickMovement(a)
+	a.pos.Store(pos)
+	if hit == nil {
+		if velocity := a.Velocity(); !velocity.ApproxEqual(mgl64.Vec3{}) {
+			yaw, pitch := rotationTowards(pos, pos.Add(velocity))
+			a.yaw.Store(yaw)
+			a.pitch.Store(pitch)
+		}
+		if pos[1] < 0 && current%10 == 0 {
+			_ = a.Close()
+		}
+		return
+	}
+	a.World().PlaySound(hit.pos, sound.ArrowHit{})
+
+	if hit.entity != nil {
+		if a.c.hitEvent(a, hit) {
+			a.hitEntity(hit)
+		}
+		_ = a.Close()
+		return
+	}
+	if !a.c.hitEvent(a, hit) {
+		// The arrow only gets stuck in the block it hit if the event was not cancelled.
+		return
+	}
+	a.collided, a.collidedPos, a.groundTicks = true, hit.blockPos, 0
+	a.SetVelocity(mgl64.Vec3{})
+}
+
+// hitEntity deals damage to the entity hit, depending on the speed of the arrow, and knocks it back. If the
+// arrow is on fire, the entity is set on fire too.
+func (a *Arrow) hitEntity(hit *projectileHit) {
+	dmg := math.Ceil(a.Velocity().Len() * a.baseDamage)
+	if a.critical {
+		dmg += float64(rand.Intn(int(dmg/2) + 2))
+	}
+	if !a.c.hurt(a, hit, dmg, 0.4+float64(a.punch)*0.6) {
+		return
+	}
+	if flammable, ok := hit.entity.(Flammable); ok && a.OnFireDuration() > 0 && flammable.OnFireDuration() < time.Second*5 {
+		flammable.SetOnFire(time.Second * 5)
+	}
+}
+
+// tickCollided ticks the arrow while it is stuck in a block. If the block is removed, the arrow starts falling
+// again. Arrows that may be picked up are collected by nearby collectors, and all arrows despawn a minute
+// after getting stuck.
+func (a *Arrow) tickCollided() {
+	w := a.World()
+	if len(boxes(w.Block(a.collidedPos), a.collidedPos, w)) == 0 {
+		a.collided = false
+		a.SetVelocity(mgl64.Vec3{0, -0.05})
+		return
+	}
+	if a.groundTicks++; a.groundTicks > 1200 {
+		_ = a.Close()
+		return
+	}
+	if !a.pickup || a.groundTicks < 10 {
+		return
+	}
+	for _, e := range w.EntitiesWithin(a.AABB().Translate(a.Position()).Grow(1)) {
+		collector, ok := e.(item.Collector)
+		if !ok {
+			continue
+		}
+		if collector.Collect(item.NewStack(item.Arrow{}, 1)) == 0 {
+			continue
+		}
+		for _, viewer := range w.Viewers(a.Position()) {
+			viewer.ViewEntityAction(a, action.PickedUp{Collector: collector})
+		}
+		_ = a.Close()
+		return
+	}
+}
+
+// tickFire extinguishes the arrow if it is in water. Unlike other entities, arrows do not stop burning by
+// themselves.
+func (a *Arrow) tickFire() {
+	if a.OnFireDuration() <= 0 {
+		return
+	}
+	if _, ok := a.World().Block(world.BlockPosFromVec3(a.Position())).(block.Water); ok {
+		a.SetOnFire(0)
+	}
+}
+
+// OnFireDuration returns the duration that the arrow is on fire for.
+func (a *Arrow) OnFireDuration() time.Duration {
+	return time.Duration(a.fireTicks.Load()) * time.Second / 20
+}
+
+// SetOnFire sets the arrow on fire for the duration passed. Arrows that are on fire set the entities they hit
+// on fire.
+func (a *Arrow) SetOnFire(duration time.Duration) {
+	before := a.fireTicks.Swap(int64(duration.Seconds() * 20))
+	if w := a.World(); w != nil && (before > 0) != (duration > 0) {
+		for _, v := range w.Viewers(a.Position()) {
+			v.ViewEntityState(a, a.State())
+		}
+	}
+}
+
+// Velocity returns the current velocity of the arrow.
+func (a *Arrow) Velocity() mgl64.Vec3 {
+	return a.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the arrow.
+func (a *Arrow) SetVelocity(v mgl64.Vec3) {
+	a.velocity.Store(v)
+}
+
+// OnGround always returns false.
+func (a *Arrow) OnGround() bool { return false }
+
+// Yaw returns the yaw of the arrow, which depends on the direction that it is flying in.
+func (a *Arrow) Yaw() float64 {
+	return a.yaw.Load()
+}
+
+// Pitch returns the pitch of the arrow, which depends on the direction that it is flying in.
+func (a *Arrow) Pitch() float64 {
+	return a.pitch.Load()
+}
+
+// AABB ...
+func (a *Arrow) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.25, 0, -0.25}, mgl64.Vec3{0.25, 0.5, 0.25})
+}
+
+// State ...
+func (a *Arrow) State() []state.State {
+	if a.OnFireDuration() > 0 {
+		return []state.State{state.OnFire{}}
+	}
+	return nil
+}
+
+// EncodeEntity ...
+func (a *Arrow) EncodeEntity() string {
+	return "minecraft:arrow"
+}
+
+// DecodeNBT decodes the properties in a map to an Arrow and returns a new Arrow entity.
+func (a *Arrow) DecodeNBT(data map[string]interface{}) interface{} {
+	arrow := NewArrow(nbtconv.MapVec3(data, "Pos"), nbtconv.MapVec3(data, "Motion"), nil)
+	if dmg, ok := data["Damage"].(float64); ok {
+		arrow.baseDamage = dmg
+	}
+	if pickup, ok := data["Pickup"].(uint8); ok {
+		arrow.pickup = pickup == 1
+	}
+	return arrow
+}
+
+// EncodeNBT encodes the Arrow entity's properties as a map and returns it.
+func (a *Arrow) EncodeNBT() map[string]interface{} {
+	pickup := uint8(0)
+	if a.pickup {
+		pickup = 1
+	}
+	return map[string]interface{}{
+		"Pos":    nbtconv.Vec3ToFloat32Slice(a.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(a.Velocity()),
+		"Damage": a.baseDamage,
+		"Pickup": pickup,
+	}
+}
+
+// Close closes the arrow, removing it from the world that it is currently in.
+func (a *Arrow) Close() error {
+	if w := a.World(); w != nil {
+		w.RemoveEntity(a)
+	}
+	return nil
+}
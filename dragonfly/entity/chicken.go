@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Chicken is a MobType of a small passive mob found in grassy areas. Chickens panic when they are hurt.
+type Chicken struct{}
+
+// EncodeEntity ...
+func (Chicken) EncodeEntity() string {
+	return "minecraft:chicken"
+}
+
+// AABB ...
+func (Chicken) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.2, 0, -0.2}, mgl64.Vec3{0.2, 0.7, 0.2})
+}
+
+// EyeHeight ...
+func (Chicken) EyeHeight() float64 {
+	return 0.6
+}
+
+// MaxHealth ...
+func (Chicken) MaxHealth() float64 {
+	return 4
+}
+
+// Speed ...
+func (Chicken) Speed() float64 {
+	return 0.25
+}
+
+// Drops ...
+func (Chicken) Drops() []item.Stack {
+	drops := []item.Stack{item.NewStack(item.Chicken{}, 1)}
+	if n := rand.Intn(3); n > 0 {
+		drops = append(drops, item.NewStack(item.Feather{}, n))
+	}
+	return drops
+}
+
+// Goals ...
+func (Chicken) Goals() []Goal {
+	return []Goal{
+		&PanicGoal{Speed: 1.4},
+		&FollowGoal{Items: []world.Item{block.WheatSeeds{}, block.PumpkinSeeds{}, block.MelonSeeds{}, block.BeetrootSeeds{}}, Speed: 1, Distance: 10},
+		&WanderGoal{Speed: 1},
+		&LookAtPlayerGoal{Distance: 6},
+	}
+}
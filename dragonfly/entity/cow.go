@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Cow is a MobType of a passive mob found in grassy areas. Cows follow players holding wheat and panic when
+// they are hurt.
+type Cow struct{}
+
+// EncodeEntity ...
+func (Cow) EncodeEntity() string {
+	return "minecraft:cow"
+}
+
+// AABB ...
+func (Cow) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.45, 0, -0.45}, mgl64.Vec3{0.45, 1.4, 0.45})
+}
+
+// EyeHeight ...
+func (Cow) EyeHeight() float64 {
+	return 1.3
+}
+
+// MaxHealth ...
+func (Cow) MaxHealth() float64 {
+	return 10
+}
+
+// Speed ...
+func (Cow) Speed() float64 {
+	return 0.25
+}
+
+// Drops ...
+func (Cow) Drops() []item.Stack {
+	drops := []item.Stack{item.NewStack(item.Beef{}, rand.Intn(3)+1)}
+	if n := rand.Intn(3); n > 0 {
+		drops = append(drops, item.NewStack(item.Leather{}, n))
+	}
+	return drops
+}
+
+// Goals ...
+func (Cow) Goals() []Goal {
+	return []Goal{
+		&PanicGoal{Speed: 2},
+		&FollowGoal{Items: []world.Item{item.Wheat{}}, Speed: 1.25, Distance: 10},
+		&WanderGoal{Speed: 1},
+		&LookAtPlayerGoal{Distance: 6},
+	}
+}
@@ -17,6 +17,38 @@ type SourceEntityAttack struct {
 	Attacker world.Entity
 }
 
+// SourceProjectile is used for damage caused by a projectile hitting an entity, for example when a player
+// shoots another player with a bow.
+type SourceProjectile struct {
+	// Projectile holds the projectile entity that hit the entity.
+	Projectile world.Entity
+	// Owner holds the entity that launched the projectile. Owner is nil if the projectile had no owner.
+	Owner world.Entity
+}
+
+// SourceFall is used for damage caused by an entity falling from a height, or by an entity teleporting using
+// an ender pearl.
+type SourceFall struct{}
+
+// SourceExplosion is used for damage caused by an explosion, for example when primed TNT explodes close to
+// an entity.
+type SourceExplosion struct{}
+
+// SourceFire is used for damage caused by an entity being on fire.
+type SourceFire struct{}
+
+// SourceLava is used for damage caused by an entity standing in lava.
+type SourceLava struct{}
+
+// SourceDrowning is used for damage caused by an entity running out of air while underwater.
+type SourceDrowning struct{}
+
+// SourceSuffocation is used for damage caused by an entity having its head inside of a solid block.
+type SourceSuffocation struct{}
+
+// SourceLightning is used for damage caused by an entity being struck by lightning.
+type SourceLightning struct{}
+
 // SourceStarvation is used for damage caused by a completely depleted food bar.
 type SourceStarvation struct{}
 
@@ -45,6 +77,46 @@ func (SourceEntityAttack) ReducedByArmour() bool {
 	return true
 }
 
+// ReducedByArmour ...
+func (SourceProjectile) ReducedByArmour() bool {
+	return true
+}
+
+// ReducedByArmour ...
+func (SourceFall) ReducedByArmour() bool {
+	return false
+}
+
+// ReducedByArmour ...
+func (SourceExplosion) ReducedByArmour() bool {
+	return true
+}
+
+// ReducedByArmour ...
+func (SourceFire) ReducedByArmour() bool {
+	return false
+}
+
+// ReducedByArmour ...
+func (SourceLava) ReducedByArmour() bool {
+	return true
+}
+
+// ReducedByArmour ...
+func (SourceDrowning) ReducedByArmour() bool {
+	return false
+}
+
+// ReducedByArmour ...
+func (SourceSuffocation) ReducedByArmour() bool {
+	return false
+}
+
+// ReducedByArmour ...
+func (SourceLightning) ReducedByArmour() bool {
+	return true
+}
+
 // ReducedByArmour ...
 func (SourceStarvation) ReducedByArmour() bool {
 	return false
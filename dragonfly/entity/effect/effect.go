@@ -131,3 +131,67 @@ func ResultingColour(effects []entity.Effect) (color.RGBA, bool) {
 	}
 	return color.RGBA{R: uint8(r / l), G: uint8(g / l), B: uint8(b / l), A: uint8(a / l)}, ambient
 }
+
+// New returns a new effect of the same type as the effect passed with the level, duration and particle
+// settings passed. Instant effects only take over the level passed and keep their potency. Effects not
+// implemented in this package are returned with only the duration passed.
+func New(e entity.Effect, lvl int, d time.Duration, ambient, hideParticles bool) entity.Effect {
+	l := lastingEffect{Lvl: lvl, Dur: d, Ambient: ambient, HideParticles: hideParticles}
+	switch e := e.(type) {
+	case Absorption:
+		return Absorption{l}
+	case Blindness:
+		return Blindness{l}
+	case ConduitPower:
+		return ConduitPower{l}
+	case FatalPoison:
+		return FatalPoison{l}
+	case FireResistance:
+		return FireResistance{l}
+	case Haste:
+		return Haste{l}
+	case HealthBoost:
+		return HealthBoost{l}
+	case Hunger:
+		return Hunger{l}
+	case InstantDamage:
+		return InstantDamage{instantEffect: instantEffect{Lvl: lvl}, Potency: e.Potency}
+	case InstantHealth:
+		return InstantHealth{instantEffect: instantEffect{Lvl: lvl}, Potency: e.Potency}
+	case Invisibility:
+		return Invisibility{l}
+	case JumpBoost:
+		return JumpBoost{l}
+	case Levitation:
+		return Levitation{l}
+	case MiningFatigue:
+		return MiningFatigue{l}
+	case Nausea:
+		return Nausea{l}
+	case NightVision:
+		return NightVision{l}
+	case Poison:
+		return Poison{l}
+	case Regeneration:
+		return Regeneration{l}
+	case Resistance:
+		return Resistance{l}
+	case Saturation:
+		return Saturation{l}
+	case SlowFalling:
+		return SlowFalling{l}
+	case Slowness:
+		return Slowness{l}
+	case Speed:
+		return Speed{l}
+	case Strength:
+		return Strength{l}
+	case WaterBreathing:
+		return WaterBreathing{l}
+	case Weakness:
+		return Weakness{l}
+	case Wither:
+		return Wither{l}
+	}
+	return e.WithDuration(d)
+}
@@ -0,0 +1,32 @@
+package effect
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity"
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"image/color"
+	"time"
+)
+
+// FireResistance is a lasting effect that makes the affected entity immune to damage from fire and lava.
+type FireResistance struct {
+	lastingEffect
+}
+
+// Immune checks if the effect makes an entity immune to damage of the source passed.
+func (FireResistance) Immune(src damage.Source) bool {
+	switch src.(type) {
+	case damage.SourceFire, damage.SourceLava:
+		return true
+	}
+	return false
+}
+
+// WithDuration ...
+func (f FireResistance) WithDuration(d time.Duration) entity.Effect {
+	return FireResistance{f.withDuration(d)}
+}
+
+// RGBA ...
+func (FireResistance) RGBA() color.RGBA {
+	return color.RGBA{R: 0xe4, G: 0x9a, B: 0x3a, A: 0xff}
+}
@@ -0,0 +1,141 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"go.uber.org/atomic"
+	"math/rand"
+)
+
+// Egg is a throwable projectile which damages entities it hits by 0 points, knocking them back slightly. Eggs
+// have a chance to spawn a chicken where they land.
+type Egg struct {
+	pos, velocity atomic.Value
+	owner         world.Entity
+
+	c *projectileComputer
+}
+
+// NewEgg creates a new egg at the position passed, moving with the velocity passed. The owner is
+// the entity that threw the egg, or nil if it was not thrown by an entity.
+func NewEgg(pos, velocity mgl64.Vec3, owner world.Entity) *Egg {
+	e := &Egg{owner: owner, c: newProjectileComputer(0.03, 0.01)}
+	e.pos.Store(pos)
+	e.velocity.Store(velocity)
+	return e
+}
+
+// newEgg creates an egg thrown by the owner passed. It is used by the item package to launch
+// eggs without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newEgg(owner world.Entity) world.Entity {
+	return NewEgg(EyePosition(owner), DirectionVector(owner).Mul(1.5), owner)
+}
+
+// Owner returns the entity that threw the egg.
+func (e *Egg) Owner() world.Entity {
+	return e.owner
+}
+
+// Position returns the current position of the egg.
+func (e *Egg) Position() mgl64.Vec3 {
+	return e.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the egg is currently in, or nil if it is not added to a world.
+func (e *Egg) World() *world.World {
+	w, _ := world.OfEntity(e)
+	return w
+}
+
+// Tick ticks the egg, moving it and checking if it hit a block or an entity.
+func (e *Egg) Tick(current int64) {
+	pos, hit := e.c.tickMovement(e)
+	e.pos.Store(pos)
+	if hit == nil {
+		if pos[1] < 0 && current%10 == 0 {
+			_ = e.Close()
+		}
+		return
+	}
+	if e.c.hitEvent(e, hit) {
+		if hit.entity != nil {
+			e.c.hurt(e, hit, 0, 0.4)
+		}
+		e.spawnChickens(hit.pos)
+	}
+	_ = e.Close()
+}
+
+// spawnChickens has a 1 in 8 chance to spawn a chicken at the position passed. If a chicken is spawned, there
+// is a 1 in 32 chance to spawn four chickens instead.
+func (e *Egg) spawnChickens(pos mgl64.Vec3) {
+	if rand.Intn(8) != 0 {
+		return
+	}
+	n := 1
+	if rand.Intn(32) == 0 {
+		n = 4
+	}
+	for i := 0; i < n; i++ {
+		e.World().AddEntity(NewMob(Chicken{}, pos))
+	}
+}
+
+// Velocity returns the current velocity of the egg.
+func (e *Egg) Velocity() mgl64.Vec3 {
+	return e.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the egg.
+func (e *Egg) SetVelocity(v mgl64.Vec3) {
+	e.velocity.Store(v)
+}
+
+// OnGround always returns false.
+func (e *Egg) OnGround() bool { return false }
+
+// Yaw always returns 0.
+func (e *Egg) Yaw() float64 { return 0 }
+
+// Pitch always returns 0.
+func (e *Egg) Pitch() float64 { return 0 }
+
+// AABB ...
+func (e *Egg) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.125, 0, -0.125}, mgl64.Vec3{0.125, 0.25, 0.125})
+}
+
+// State ...
+func (e *Egg) State() []state.State {
+	return nil
+}
+
+// EncodeEntity ...
+func (e *Egg) EncodeEntity() string {
+	return "minecraft:egg"
+}
+
+// DecodeNBT decodes the properties in a map to a Egg and returns a new Egg entity.
+func (e *Egg) DecodeNBT(data map[string]interface{}) interface{} {
+	return NewEgg(nbtconv.MapVec3(data, "Pos"), nbtconv.MapVec3(data, "Motion"), nil)
+}
+
+// EncodeNBT encodes the Egg entity's properties as a map and returns it.
+func (e *Egg) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"Pos":    nbtconv.Vec3ToFloat32Slice(e.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(e.Velocity()),
+	}
+}
+
+// Close closes the egg, removing it from the world that it is currently in.
+func (e *Egg) Close() error {
+	if w := e.World(); w != nil {
+		w.RemoveEntity(e)
+	}
+	return nil
+}
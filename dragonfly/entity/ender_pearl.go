@@ -0,0 +1,146 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"go.uber.org/atomic"
+)
+
+// EnderPearl is a throwable projectile which teleports the entity that threw it to the position where it lands.
+// The thrower takes 5 points of fall damage when teleported.
+type EnderPearl struct {
+	pos, velocity atomic.Value
+	owner         world.Entity
+
+	c *projectileComputer
+}
+
+// NewEnderPearl creates a new ender pearl at the position passed, moving with the velocity passed. The owner is
+// the entity that threw the ender pearl, or nil if it was not thrown by an entity.
+func NewEnderPearl(pos, velocity mgl64.Vec3, owner world.Entity) *EnderPearl {
+	e := &EnderPearl{owner: owner, c: newProjectileComputer(0.03, 0.01)}
+	e.pos.Store(pos)
+	e.velocity.Store(velocity)
+	return e
+}
+
+// newEnderPearl creates an ender pearl thrown by the owner passed. It is used by the item package to launch
+// ender pearls without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newEnderPearl(owner world.Entity) world.Entity {
+	return NewEnderPearl(EyePosition(owner), DirectionVector(owner).Mul(1.5), owner)
+}
+
+// Owner returns the entity that threw the ender pearl.
+func (e *EnderPearl) Owner() world.Entity {
+	return e.owner
+}
+
+// Position returns the current position of the ender pearl.
+func (e *EnderPearl) Position() mgl64.Vec3 {
+	return e.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the ender pearl is currently in, or nil if it is not added to a world.
+func (e *EnderPearl) World() *world.World {
+	w, _ := world.OfEntity(e)
+	return w
+}
+
+// Tick ticks the ender pearl, moving it and checking if it hit a block or an entity.
+func (e *EnderPearl) Tick(current int64) {
+	pos, hit := e.c.tickMovement(e)
+	e.pos.Store(pos)
+	if hit == nil {
+		if pos[1] < 0 && current%10 == 0 {
+			_ = e.Close()
+		}
+		return
+	}
+	if e.c.hitEvent(e, hit) {
+		if hit.entity != nil {
+			e.c.hurt(e, hit, 0, 0.4)
+		}
+		e.teleportOwner(hit.pos)
+	}
+	_ = e.Close()
+}
+
+// teleportOwner teleports the owner of the ender pearl to the position passed, provided the owner is still
+// alive and in the same world, and deals fall damage to it.
+func (e *EnderPearl) teleportOwner(pos mgl64.Vec3) {
+	owner, ok := e.owner.(interface {
+		Living
+		Teleport(pos mgl64.Vec3)
+	})
+	if !ok || owner.Health() <= 0 || owner.World() != e.World() {
+		return
+	}
+	e.World().PlaySound(owner.Position(), sound.Teleport{})
+	owner.Teleport(pos)
+	e.World().PlaySound(pos, sound.Teleport{})
+	if e.World().GameRule(gamerule.FallDamage{}).(bool) {
+		owner.Hurt(5, damage.SourceFall{})
+	}
+}
+
+// Velocity returns the current velocity of the ender pearl.
+func (e *EnderPearl) Velocity() mgl64.Vec3 {
+	return e.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the ender pearl.
+func (e *EnderPearl) SetVelocity(v mgl64.Vec3) {
+	e.velocity.Store(v)
+}
+
+// OnGround always returns false.
+func (e *EnderPearl) OnGround() bool { return false }
+
+// Yaw always returns 0.
+func (e *EnderPearl) Yaw() float64 { return 0 }
+
+// Pitch always returns 0.
+func (e *EnderPearl) Pitch() float64 { return 0 }
+
+// AABB ...
+func (e *EnderPearl) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.125, 0, -0.125}, mgl64.Vec3{0.125, 0.25, 0.125})
+}
+
+// State ...
+func (e *EnderPearl) State() []state.State {
+	return nil
+}
+
+// EncodeEntity ...
+func (e *EnderPearl) EncodeEntity() string {
+	return "minecraft:ender_pearl"
+}
+
+// DecodeNBT decodes the properties in a map to a EnderPearl and returns a new EnderPearl entity.
+func (e *EnderPearl) DecodeNBT(data map[string]interface{}) interface{} {
+	return NewEnderPearl(nbtconv.MapVec3(data, "Pos"), nbtconv.MapVec3(data, "Motion"), nil)
+}
+
+// EncodeNBT encodes the EnderPearl entity's properties as a map and returns it.
+func (e *EnderPearl) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"Pos":    nbtconv.Vec3ToFloat32Slice(e.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(e.Velocity()),
+	}
+}
+
+// Close closes the ender pearl, removing it from the world that it is currently in.
+func (e *EnderPearl) Close() error {
+	if w := e.World(); w != nil {
+		w.RemoveEntity(e)
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/event"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/df-mc/dragonfly/dragonfly/world/particle"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// explosionRays holds the normalised directions of the rays cast by an explosion. Similarly to vanilla, the
+// rays are cast towards all points on the surface of a 16x16x16 cube.
+var explosionRays = func() []mgl64.Vec3 {
+	rays := make([]mgl64.Vec3, 0, 1352)
+	for x := 0; x < 16; x++ {
+		for y := 0; y < 16; y++ {
+			for z := 0; z < 16; z++ {
+				if x != 0 && x != 15 && y != 0 && y != 15 && z != 0 && z != 15 {
+					continue
+				}
+				rays = append(rays, mgl64.Vec3{float64(x)/7.5 - 1, float64(y)/7.5 - 1, float64(z)/7.5 - 1}.Normalize())
+			}
+		}
+	}
+	return rays
+}()
+
+// explode creates an explosion in the world passed. It is used by the world package to create explosions
+// without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func explode(w *world.World, pos mgl64.Vec3, power float64, config world.ExplosionConfig) {
+	r := power * 2
+	entities := w.EntitiesWithin(physics.NewAABB(pos, pos).Grow(r))
+	if config.Source != nil {
+		for i, e := range entities {
+			if e == config.Source {
+				entities = append(entities[:i], entities[i+1:]...)
+				break
+			}
+		}
+	}
+	griefing := true
+	if _, ok := config.Source.(*Mob); ok {
+		// Explosions caused by mobs only destroy blocks if the MobGriefing game rule is enabled.
+		griefing = w.GameRule(gamerule.MobGriefing{}).(bool)
+	}
+	var blocks []world.BlockPos
+	if !config.KeepBlocks && griefing {
+		blocks = explosionBlocks(w, pos, power)
+	}
+	itemDropChance, spawnFire := config.ItemDropChance, config.SpawnFire
+	if itemDropChance == 0 {
+		itemDropChance = 1 / power
+	}
+
+	ctx := event.C()
+	w.Handler().HandleExplosion(ctx, pos, &entities, &blocks, &itemDropChance, &spawnFire)
+	ctx.Continue(func() {
+		w.PlaySound(pos, sound.Explosion{})
+		w.AddParticle(pos, particle.HugeExplosion{})
+
+		for _, e := range entities {
+			explodeEntity(w, e, pos, r)
+		}
+		if !w.GameRule(gamerule.DoTileDrops{}).(bool) {
+			itemDropChance = 0
+		}
+		for _, blockPos := range blocks {
+			explodeBlock(w, blockPos, itemDropChance)
+		}
+		if spawnFire {
+			for _, blockPos := range blocks {
+				if rand.Intn(3) != 0 {
+					continue
+				}
+				below := blockPos.Side(world.FaceDown)
+				if _, ok := w.Block(blockPos).(block.Air); ok && len(boxes(w.Block(below), below, w)) != 0 {
+					w.PlaceBlock(blockPos, block.Fire{})
+				}
+			}
+		}
+	})
+}
+
+// explosionBlocks returns the positions of all blocks destroyed by an explosion at the position passed with
+// the power passed. Every ray of the explosion loses intensity for every step it takes and for every block
+// it passes through, depending on the blast resistance of that block.
+func explosionBlocks(w *world.World, pos mgl64.Vec3, power float64) []world.BlockPos {
+	affected := make(map[world.BlockPos]struct{})
+	var blocks []world.BlockPos
+	for _, ray := range explosionRays {
+		current := pos
+		for intensity := power * (0.7 + rand.Float64()*0.6); intensity > 0; intensity -= 0.225 {
+			blockPos := world.BlockPosFromVec3(current)
+			if blockPos.OutOfBounds() {
+				break
+			}
+			resistance, destructible := blastResistance(w, blockPos)
+			if resistance >= 0 {
+				if intensity -= (resistance + 0.3) * 0.3; intensity <= 0 {
+					break
+				}
+				if _, ok := affected[blockPos]; !ok && destructible {
+					affected[blockPos] = struct{}{}
+					blocks = append(blocks, blockPos)
+				}
+			}
+			current = current.Add(ray.Mul(0.3))
+		}
+	}
+	return blocks
+}
+
+// blastResistance returns the blast resistance of the block at the position passed, and if the block may be
+// destroyed by an explosion at all. If the block is air, a negative resistance is returned.
+func blastResistance(w *world.World, pos world.BlockPos) (resistance float64, destructible bool) {
+	if _, ok := w.Liquid(pos); ok {
+		return 100, false
+	}
+	switch b := w.Block(pos).(type) {
+	case block.Air:
+		return -1, false
+	case block.Breakable:
+		return b.BreakInfo().BlastResistance, true
+	}
+	return math.MaxFloat64, false
+}
+
+// explodeBlock destroys the block at the position passed as the result of an explosion. Items are dropped
+// with the chance passed. TNT hit by the explosion is ignited with a short fuse rather than destroyed, unless
+// the TNTExplodes game rule is disabled.
+func explodeBlock(w *world.World, pos world.BlockPos, itemDropChance float64) {
+	b := w.Block(pos)
+	if _, ok := b.(block.TNT); ok && w.GameRule(gamerule.TNTExplodes{}).(bool) {
+		w.SetBlock(pos, block.Air{})
+		w.AddEntity(NewTNT(pos.Vec3Middle(), time.Duration(rand.Intn(20)+10)*time.Second/20))
+		return
+	}
+	w.SetBlock(pos, block.Air{})
+	if breakable, ok := b.(block.Breakable); ok && rand.Float64() < itemDropChance {
+		for _, drop := range breakable.BreakInfo().Drops(tool.None{}) {
+			itemEntity := NewItem(drop, pos.Vec3Centre())
+			itemEntity.SetVelocity(mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1})
+			w.AddEntity(itemEntity)
+		}
+	}
+}
+
+// explodeEntity damages and knocks back an entity hit by an explosion at the position passed. The impact of
+// the explosion depends on the distance of the entity to the explosion and on how much of the entity is
+// exposed to it.
+func explodeEntity(w *world.World, e world.Entity, pos mgl64.Vec3, r float64) {
+	diff := e.Position().Sub(pos)
+	dist := diff.Len()
+	if dist > r {
+		return
+	}
+	impact := (1 - dist/r) * exposure(w, pos, e.AABB().Translate(e.Position()))
+	if impact <= 0 {
+		return
+	}
+	if living, ok := e.(Living); ok {
+		living.Hurt(math.Floor((impact*impact+impact)*3.5*r+1), damage.SourceExplosion{})
+	}
+	if dist != 0 {
+		e.SetVelocity(e.Velocity().Add(diff.Normalize().Mul(impact)))
+	}
+}
+
+// exposure returns the fraction of the AABB passed that is exposed to an explosion at the position passed,
+// by casting rays from points spread over the AABB towards the position of the explosion.
+func exposure(w *world.World, pos mgl64.Vec3, aabb physics.AABB) float64 {
+	min, max := aabb.Min(), aabb.Max()
+	step := max.Sub(min).Mul(2).Add(mgl64.Vec3{1, 1, 1})
+	step = mgl64.Vec3{1 / step[0], 1 / step[1], 1 / step[2]}
+
+	var exposed, total float64
+	for x := 0.0; x <= 1; x += step[0] {
+		for y := 0.0; y <= 1; y += step[1] {
+			for z := 0.0; z <= 1; z += step[2] {
+				point := mgl64.Vec3{
+					min[0] + (max[0]-min[0])*x,
+					min[1] + (max[1]-min[1])*y,
+					min[2] + (max[2]-min[2])*z,
+				}
+				if !obstructed(w, point, pos) {
+					exposed++
+				}
+				total++
+			}
+		}
+	}
+	if total == 0 {
+		return 1
+	}
+	return exposed / total
+}
+
+// obstructed checks if the line between the two points passed passes through the collision boxes of any
+// block in the world.
+func obstructed(w *world.World, a, b mgl64.Vec3) bool {
+	diff := b.Sub(a)
+	dist := diff.Len()
+	if dist == 0 {
+		return false
+	}
+	dir := diff.Mul(1 / dist)
+	for d := 0.0; d < dist; d += 0.1 {
+		point := a.Add(dir.Mul(d))
+		blockPos := world.BlockPosFromVec3(point)
+		if _, ok := w.Block(blockPos).(block.Air); ok {
+			continue
+		}
+		rel := point.Sub(blockPos.Vec3())
+		for _, box := range boxes(w.Block(blockPos), blockPos, w) {
+			if box.Vec3Within(rel) {
+				return true
+			}
+		}
+	}
+	return false
+}
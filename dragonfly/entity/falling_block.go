@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/item_internal"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/go-gl/mathgl/mgl64"
+	"sync/atomic"
+)
+
+// FallingBlock is an entity created when a block affected by gravity, such as sand, is no longer supported.
+// The falling block places its block again once it lands.
+type FallingBlock struct {
+	b             world.Block
+	velocity, pos atomic.Value
+
+	c *MovementComputer
+}
+
+// NewFallingBlock creates a new falling block entity holding the block passed at the position passed.
+func NewFallingBlock(b world.Block, pos mgl64.Vec3) *FallingBlock {
+	f := &FallingBlock{b: b, c: NewMovementComputer(0.04, true)}
+	f.pos.Store(pos)
+	f.velocity.Store(mgl64.Vec3{})
+	return f
+}
+
+// newFallingBlock creates a falling block at the position passed. It is used by the block package to make
+// blocks fall without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newFallingBlock(b world.Block, pos mgl64.Vec3) world.Entity {
+	return NewFallingBlock(b, pos)
+}
+
+// Block returns the block that the falling block entity holds.
+func (f *FallingBlock) Block() world.Block {
+	return f.b
+}
+
+// Position returns the current position of the falling block.
+func (f *FallingBlock) Position() mgl64.Vec3 {
+	return f.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the falling block is currently in, or nil if it is not added to a world.
+func (f *FallingBlock) World() *world.World {
+	w, _ := world.OfEntity(f)
+	return w
+}
+
+// OnGround checks if the falling block is currently on the ground.
+func (f *FallingBlock) OnGround() bool {
+	return f.c.OnGround()
+}
+
+// Tick ticks the falling block, moving it and placing its block once it lands. If the block cannot be placed
+// where it lands, it is dropped as an item instead if the DoEntityDrops game rule is enabled.
+func (f *FallingBlock) Tick(current int64) {
+	w := f.World()
+	if w == nil {
+		return
+	}
+	if f.Position()[1] < 0 && current%10 == 0 {
+		_ = f.Close()
+		return
+	}
+	f.pos.Store(f.c.TickMovement(f))
+	pos := world.BlockPosFromVec3(f.Position())
+
+	if s, ok := f.b.(block.Solidifiable); ok && s.Solidifies(pos, w) {
+		_ = f.Close()
+		w.PlaceBlock(pos, s.Solidified())
+		return
+	}
+	if !f.OnGround() {
+		return
+	}
+	_ = f.Close()
+	if item_internal.Replaceable(w, pos, f.b) {
+		w.PlaceBlock(pos, f.b)
+		if l, ok := f.b.(block.Landable); ok {
+			l.Landed(pos, w)
+		}
+	} else if i, ok := f.b.(world.Item); ok && w.GameRule(gamerule.DoEntityDrops{}).(bool) {
+		w.AddEntity(NewItem(item.NewStack(i, 1), pos.Vec3Middle()))
+	}
+}
+
+// Velocity returns the current velocity of the falling block. The values in the Vec3 returned represent the
+// speed on that axis in blocks/tick.
+func (f *FallingBlock) Velocity() mgl64.Vec3 {
+	return f.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the falling block. The values in the Vec3 passed represent the speed on
+// that axis in blocks/tick.
+func (f *FallingBlock) SetVelocity(v mgl64.Vec3) {
+	f.velocity.Store(v)
+}
+
+// Yaw always returns 0.
+func (f *FallingBlock) Yaw() float64 { return 0 }
+
+// Pitch always returns 0.
+func (f *FallingBlock) Pitch() float64 { return 0 }
+
+// AABB ...
+func (f *FallingBlock) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.49, 0, -0.49}, mgl64.Vec3{0.49, 0.98, 0.49})
+}
+
+// State ...
+func (f *FallingBlock) State() []state.State {
+	return nil
+}
+
+// EncodeEntity ...
+func (f *FallingBlock) EncodeEntity() string {
+	return "minecraft:falling_block"
+}
+
+// DecodeNBT decodes the properties in a map to a FallingBlock and returns a new FallingBlock entity.
+func (f *FallingBlock) DecodeNBT(data map[string]interface{}) interface{} {
+	b := nbtconv.MapBlock(data, "FallingBlock")
+	if b == nil {
+		return nil
+	}
+	n := NewFallingBlock(b, nbtconv.MapVec3(data, "Pos"))
+	n.SetVelocity(nbtconv.MapVec3(data, "Motion"))
+	return n
+}
+
+// EncodeNBT encodes the FallingBlock entity's properties as a map and returns it.
+func (f *FallingBlock) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"FallingBlock": nbtconv.BlockToNBT(f.b),
+		"Pos":          nbtconv.Vec3ToFloat32Slice(f.Position()),
+		"Motion":       nbtconv.Vec3ToFloat32Slice(f.Velocity()),
+	}
+}
+
+// Close closes the falling block, removing it from the world that it is currently in.
+func (f *FallingBlock) Close() error {
+	if w := f.World(); w != nil {
+		w.RemoveEntity(f)
+	}
+	return nil
+}
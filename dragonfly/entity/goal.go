@@ -0,0 +1,164 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"math/rand"
+)
+
+// Goal represents a behaviour of a Mob, such as wandering around or attacking a target. The goals of a mob are
+// executed by its goal selector: Every tick, goals that are able to start are started, provided no goal with a
+// higher priority uses the same controls of the mob.
+type Goal interface {
+	// Controls returns the controls of the mob that the goal uses. Two goals that use the same controls are
+	// never executed at the same time.
+	Controls() Control
+	// CanStart checks if the goal is able to start executing for the mob passed.
+	CanStart(m *Mob) bool
+	// CanContinue checks if the goal, which is currently executing, is able to continue executing for the mob
+	// passed. If false is returned, the goal is stopped.
+	CanContinue(m *Mob) bool
+	// Start is called when the goal starts executing for the mob passed.
+	Start(m *Mob)
+	// Tick is called every tick while the goal is executing for the mob passed.
+	Tick(m *Mob)
+	// Stop is called when the goal stops executing for the mob passed, either because CanContinue returned
+	// false or because a goal with a higher priority took over its controls.
+	Stop(m *Mob)
+}
+
+// Control is a control of a Mob that a Goal may use. Controls may be combined using a bitwise OR.
+type Control uint8
+
+const (
+	// ControlMove is the control used by goals that move the mob around.
+	ControlMove Control = 1 << iota
+	// ControlLook is the control used by goals that change the direction that the mob is looking in.
+	ControlLook
+	// ControlTarget is the control used by goals that change the target of the mob.
+	ControlTarget
+)
+
+// goalSelector executes the goals of a mob in order of priority.
+type goalSelector struct {
+	goals []*goalEntry
+}
+
+// goalEntry is a goal held by a goalSelector, together with whether it is currently executing.
+type goalEntry struct {
+	g       Goal
+	running bool
+}
+
+// newGoalSelector returns a goal selector for the goals passed. The goals passed are sorted in order of
+// priority: The first goal has the highest priority.
+func newGoalSelector(goals []Goal) *goalSelector {
+	s := &goalSelector{goals: make([]*goalEntry, len(goals))}
+	for i, g := range goals {
+		s.goals[i] = &goalEntry{g: g}
+	}
+	return s
+}
+
+// tick ticks the goal selector for the mob passed, stopping goals that can no longer continue, starting goals
+// that are able to start and ticking all goals that are executing.
+func (s *goalSelector) tick(m *Mob) {
+	for _, e := range s.goals {
+		if e.running && !e.g.CanContinue(m) {
+			s.stop(e, m)
+		}
+	}
+	for i, e := range s.goals {
+		if e.running || s.blocked(i) || !e.g.CanStart(m) {
+			continue
+		}
+		// Goals with a lower priority that use the same controls are stopped, so that this goal can take over.
+		for _, other := range s.goals[i+1:] {
+			if other.running && other.g.Controls()&e.g.Controls() != 0 {
+				s.stop(other, m)
+			}
+		}
+		e.running = true
+		e.g.Start(m)
+	}
+	for _, e := range s.goals {
+		if e.running {
+			e.g.Tick(m)
+		}
+	}
+}
+
+// blocked checks if the goal at the index passed is blocked from starting by a goal with a higher priority
+// that is currently executing and that uses one of its controls.
+func (s *goalSelector) blocked(index int) bool {
+	controls := s.goals[index].g.Controls()
+	for _, e := range s.goals[:index] {
+		if e.running && e.g.Controls()&controls != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// stopAll stops all goals of the goal selector that are currently executing.
+func (s *goalSelector) stopAll(m *Mob) {
+	for _, e := range s.goals {
+		if e.running {
+			s.stop(e, m)
+		}
+	}
+}
+
+// stop stops the goal entry passed.
+func (s *goalSelector) stop(e *goalEntry, m *Mob) {
+	e.running = false
+	e.g.Stop(m)
+}
+
+// player is implemented by players. Goals use it to find players near a mob.
+type player interface {
+	Living
+	// GameMode returns the game mode of the player.
+	GameMode() gamemode.GameMode
+}
+
+// nearestPlayer returns the player nearest to the mob passed within the distance passed, for which the filter
+// passed returns true. If no such player could be found, false is returned.
+func nearestPlayer(m *Mob, distance float64, filter func(p player) bool) (player, bool) {
+	pos := m.Position()
+	var nearest player
+	nearestDist := math.MaxFloat64
+
+	for _, e := range m.World().EntitiesWithin(physics.NewAABB(pos, pos).Grow(distance)) {
+		p, ok := e.(player)
+		if !ok || p.Health() <= 0 || !filter(p) {
+			continue
+		}
+		if dist := p.Position().Sub(pos).Len(); dist <= distance && dist < nearestDist {
+			nearest, nearestDist = p, dist
+		}
+	}
+	return nearest, nearest != nil
+}
+
+// attackable checks if the player passed can be attacked by mobs, which is only the case if the player is
+// in survival or adventure mode.
+func attackable(p player) bool {
+	switch p.GameMode().(type) {
+	case gamemode.Survival, gamemode.Adventure:
+		return true
+	}
+	return false
+}
+
+// randomPosition returns a random position around the mob passed within the horizontal and vertical range
+// passed.
+func randomPosition(m *Mob, horizontal, vertical int) mgl64.Vec3 {
+	return m.Position().Add(mgl64.Vec3{
+		float64(rand.Intn(horizontal*2+1) - horizontal),
+		float64(rand.Intn(vertical*2+1) - vertical),
+		float64(rand.Intn(horizontal*2+1) - horizontal),
+	})
+}
@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
+	"math"
+)
+
+// AttackMeleeGoal is a Goal that makes a mob chase its target and attack it once it is close enough. The
+// target of the mob is typically set by a TargetPlayerGoal.
+type AttackMeleeGoal struct {
+	// Speed is the speed multiplier that the mob chases its target with.
+	Speed float64
+	// Damage is the damage that the mob deals to its target on normal difficulty. On easy difficulty, less
+	// damage is dealt, and on hard difficulty more.
+	Damage float64
+
+	cooldown, ticks int
+}
+
+// Controls ...
+func (g *AttackMeleeGoal) Controls() Control {
+	return ControlMove | ControlLook
+}
+
+// CanStart ...
+func (g *AttackMeleeGoal) CanStart(m *Mob) bool {
+	_, ok := m.Target()
+	return ok
+}
+
+// CanContinue ...
+func (g *AttackMeleeGoal) CanContinue(m *Mob) bool {
+	return g.CanStart(m)
+}
+
+// Start ...
+func (g *AttackMeleeGoal) Start(*Mob) {
+	g.ticks = 0
+}
+
+// Tick ...
+func (g *AttackMeleeGoal) Tick(m *Mob) {
+	target, ok := m.Target()
+	if !ok {
+		return
+	}
+	m.LookAt(EyePosition(target))
+	if g.ticks--; g.ticks <= 0 {
+		// Find a new path every half second, as the target is likely to move around.
+		g.ticks = 10
+		m.Navigate(target.Position(), g.Speed)
+	}
+	if g.cooldown > 0 {
+		g.cooldown--
+	}
+	width := m.AABB().Width() * 2
+	reach := math.Sqrt(width*width + target.AABB().Width())
+	if g.cooldown > 0 || target.Position().Sub(m.Position()).Len() > reach {
+		return
+	}
+	g.cooldown = 20
+	m.SwingArm()
+	if target.AttackImmune() {
+		return
+	}
+	target.Hurt(g.damage(m), damage.SourceEntityAttack{Attacker: m})
+	target.KnockBack(m.Position(), 0.4, 0.4)
+}
+
+// Stop ...
+func (g *AttackMeleeGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// damage returns the damage dealt by the mob passed, taking into account the difficulty of its world.
+func (g *AttackMeleeGoal) damage(m *Mob) float64 {
+	switch m.World().Difficulty().(type) {
+	case difficulty.Easy:
+		return math.Min(g.Damage/2+1, g.Damage)
+	case difficulty.Hard:
+		return g.Damage * 1.5
+	}
+	return g.Damage
+}
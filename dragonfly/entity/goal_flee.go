@@ -0,0 +1,77 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// FleeGoal is a Goal that makes a mob run away from entities nearby, such as a villager fleeing from a
+// zombie.
+type FleeGoal struct {
+	// Speed is the speed multiplier that the mob flees with.
+	Speed float64
+	// Distance is the distance within which the mob flees from entities.
+	Distance float64
+	// From is called for every entity within Distance of the mob. If it returns true, the mob flees from the
+	// entity.
+	From func(e world.Entity) bool
+}
+
+// Controls ...
+func (g *FleeGoal) Controls() Control {
+	return ControlMove
+}
+
+// CanStart ...
+func (g *FleeGoal) CanStart(m *Mob) bool {
+	_, ok := g.threat(m)
+	return ok
+}
+
+// CanContinue ...
+func (g *FleeGoal) CanContinue(m *Mob) bool {
+	return m.Navigating()
+}
+
+// Start ...
+func (g *FleeGoal) Start(m *Mob) {
+	threat, ok := g.threat(m)
+	if !ok {
+		return
+	}
+	away := m.Position().Sub(threat.Position())
+	away[1] = 0
+	if away.Len() == 0 {
+		away = mgl64.Vec3{1, 0, 0}
+	}
+	m.Navigate(m.Position().Add(away.Normalize().Mul(g.Distance)), g.Speed)
+}
+
+// Tick ...
+func (g *FleeGoal) Tick(*Mob) {}
+
+// Stop ...
+func (g *FleeGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
+
+// threat returns the entity nearest to the mob passed that it should flee from.
+func (g *FleeGoal) threat(m *Mob) (world.Entity, bool) {
+	if g.From == nil {
+		return nil, false
+	}
+	pos := m.Position()
+	var nearest world.Entity
+	nearestDist := g.Distance
+
+	for _, e := range m.World().EntitiesWithin(physics.NewAABB(pos, pos).Grow(g.Distance)) {
+		if e == m || !g.From(e) {
+			continue
+		}
+		if dist := e.Position().Sub(pos).Len(); dist <= nearestDist {
+			nearest, nearestDist = e, dist
+		}
+	}
+	return nearest, nearest != nil
+}
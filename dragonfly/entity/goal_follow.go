@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+)
+
+// FollowGoal is a Goal that makes a mob follow a player nearby that holds one of the items that the mob is
+// tempted by, such as a cow following a player holding wheat.
+type FollowGoal struct {
+	// Items holds the items that tempt the mob. The mob follows players that hold any of these items in one
+	// of their hands.
+	Items []world.Item
+	// Speed is the speed multiplier that the mob follows the player with.
+	Speed float64
+	// Distance is the maximum distance that a player may be away from the mob for the mob to follow it.
+	Distance float64
+
+	target   player
+	cooldown int
+	ticks    int
+}
+
+// Controls ...
+func (g *FollowGoal) Controls() Control {
+	return ControlMove | ControlLook
+}
+
+// CanStart ...
+func (g *FollowGoal) CanStart(m *Mob) bool {
+	if g.cooldown > 0 {
+		g.cooldown--
+		return false
+	}
+	p, ok := nearestPlayer(m, g.Distance, g.tempted)
+	g.target = p
+	return ok
+}
+
+// CanContinue ...
+func (g *FollowGoal) CanContinue(m *Mob) bool {
+	return g.target.Health() > 0 && g.target.World() == m.World() && g.tempted(g.target) &&
+		g.target.Position().Sub(m.Position()).Len() <= g.Distance
+}
+
+// Start ...
+func (g *FollowGoal) Start(*Mob) {
+	g.ticks = 0
+}
+
+// Tick ...
+func (g *FollowGoal) Tick(m *Mob) {
+	m.LookAt(EyePosition(g.target))
+	if g.target.Position().Sub(m.Position()).Len() < 2.5 {
+		m.StopNavigating()
+		return
+	}
+	if g.ticks--; g.ticks <= 0 {
+		// Find a new path every half second, as the player is likely to move around.
+		g.ticks = 10
+		m.Navigate(g.target.Position(), g.Speed)
+	}
+}
+
+// Stop ...
+func (g *FollowGoal) Stop(m *Mob) {
+	g.target, g.cooldown = nil, 100
+	m.StopNavigating()
+}
+
+// tempted checks if the player passed holds any of the items of the FollowGoal.
+func (g *FollowGoal) tempted(p player) bool {
+	holder, ok := p.(interface {
+		HeldItems() (mainHand, offHand item.Stack)
+	})
+	if !ok {
+		return false
+	}
+	mainHand, offHand := holder.HeldItems()
+	for _, held := range []item.Stack{mainHand, offHand} {
+		if held.Empty() {
+			continue
+		}
+		id, meta := held.Item().EncodeItem()
+		for _, i := range g.Items {
+			if otherID, otherMeta := i.EncodeItem(); id == otherID && meta == otherMeta {
+				return true
+			}
+		}
+	}
+	return false
+}
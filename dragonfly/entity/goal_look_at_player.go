@@ -0,0 +1,49 @@
+package entity
+
+import "math/rand"
+
+// LookAtPlayerGoal is a Goal that makes a mob look at a player nearby for a short while.
+type LookAtPlayerGoal struct {
+	// Distance is the maximum distance that a player may be away from the mob for the mob to look at it.
+	Distance float64
+
+	target player
+	ticks  int
+}
+
+// Controls ...
+func (g *LookAtPlayerGoal) Controls() Control {
+	return ControlLook
+}
+
+// CanStart ...
+func (g *LookAtPlayerGoal) CanStart(m *Mob) bool {
+	if rand.Intn(50) != 0 {
+		return false
+	}
+	p, ok := nearestPlayer(m, g.Distance, func(player) bool { return true })
+	g.target = p
+	return ok
+}
+
+// CanContinue ...
+func (g *LookAtPlayerGoal) CanContinue(m *Mob) bool {
+	return g.ticks > 0 && g.target.Health() > 0 && g.target.World() == m.World() &&
+		g.target.Position().Sub(m.Position()).Len() <= g.Distance
+}
+
+// Start ...
+func (g *LookAtPlayerGoal) Start(*Mob) {
+	g.ticks = 40 + rand.Intn(40)
+}
+
+// Tick ...
+func (g *LookAtPlayerGoal) Tick(m *Mob) {
+	g.ticks--
+	m.LookAt(EyePosition(g.target))
+}
+
+// Stop ...
+func (g *LookAtPlayerGoal) Stop(*Mob) {
+	g.target = nil
+}
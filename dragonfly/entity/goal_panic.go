@@ -0,0 +1,37 @@
+package entity
+
+import "time"
+
+// PanicGoal is a Goal that makes a mob run around randomly for a while after it was hurt.
+type PanicGoal struct {
+	// Speed is the speed multiplier that the mob runs around with while panicking.
+	Speed float64
+}
+
+// Controls ...
+func (g *PanicGoal) Controls() Control {
+	return ControlMove
+}
+
+// CanStart ...
+func (g *PanicGoal) CanStart(m *Mob) bool {
+	return m.HurtWithin(time.Second * 5)
+}
+
+// CanContinue ...
+func (g *PanicGoal) CanContinue(m *Mob) bool {
+	return m.Navigating()
+}
+
+// Start ...
+func (g *PanicGoal) Start(m *Mob) {
+	m.Navigate(randomPosition(m, 5, 2), g.Speed)
+}
+
+// Tick ...
+func (g *PanicGoal) Tick(*Mob) {}
+
+// Stop ...
+func (g *PanicGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
+	"math/rand"
+	"time"
+)
+
+// TargetPlayerGoal is a Goal that makes a mob target the nearest player in survival or adventure mode, or the
+// player that last attacked it. It is typically combined with the AttackMeleeGoal.
+// Players are never targeted if the difficulty of the world is peaceful.
+type TargetPlayerGoal struct {
+	// Distance is the maximum distance that a player may be away from the mob for the mob to target it.
+	Distance float64
+
+	target player
+}
+
+// Controls ...
+func (g *TargetPlayerGoal) Controls() Control {
+	return ControlTarget
+}
+
+// CanStart ...
+func (g *TargetPlayerGoal) CanStart(m *Mob) bool {
+	if _, peaceful := m.World().Difficulty().(difficulty.Peaceful); peaceful {
+		return false
+	}
+	if attacker, ok := m.Attacker(time.Second * 5); ok {
+		// Mobs always retaliate against players that attacked them.
+		if p, ok := attacker.(player); ok && p.Health() > 0 && attackable(p) {
+			g.target = p
+			return true
+		}
+	}
+	if rand.Intn(10) != 0 {
+		return false
+	}
+	p, ok := nearestPlayer(m, g.Distance, attackable)
+	g.target = p
+	return ok
+}
+
+// CanContinue ...
+func (g *TargetPlayerGoal) CanContinue(m *Mob) bool {
+	if _, peaceful := m.World().Difficulty().(difficulty.Peaceful); peaceful {
+		return false
+	}
+	return g.target.Health() > 0 && g.target.World() == m.World() && attackable(g.target) &&
+		g.target.Position().Sub(m.Position()).Len() <= g.Distance*1.5
+}
+
+// Start ...
+func (g *TargetPlayerGoal) Start(m *Mob) {
+	m.SetTarget(g.target)
+}
+
+// Tick ...
+func (g *TargetPlayerGoal) Tick(*Mob) {}
+
+// Stop ...
+func (g *TargetPlayerGoal) Stop(m *Mob) {
+	g.target = nil
+	m.SetTarget(nil)
+}
@@ -0,0 +1,37 @@
+package entity
+
+import "math/rand"
+
+// WanderGoal is a Goal that makes a mob walk around randomly when it has nothing else to do.
+type WanderGoal struct {
+	// Speed is the speed multiplier that the mob wanders around with.
+	Speed float64
+}
+
+// Controls ...
+func (g *WanderGoal) Controls() Control {
+	return ControlMove
+}
+
+// CanStart ...
+func (g *WanderGoal) CanStart(m *Mob) bool {
+	return !m.Navigating() && rand.Intn(120) == 0
+}
+
+// CanContinue ...
+func (g *WanderGoal) CanContinue(m *Mob) bool {
+	return m.Navigating()
+}
+
+// Start ...
+func (g *WanderGoal) Start(m *Mob) {
+	m.Navigate(randomPosition(m, 10, 3), g.Speed)
+}
+
+// Tick ...
+func (g *WanderGoal) Tick(*Mob) {}
+
+// Stop ...
+func (g *WanderGoal) Stop(m *Mob) {
+	m.StopNavigating()
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/entity/action"
 	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
 	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
@@ -17,7 +18,7 @@ type Item struct {
 	i             item.Stack
 	velocity, pos atomic.Value
 
-	*movementComputer
+	c *MovementComputer
 }
 
 // NewItem creates a new item entity using the item stack passed. The item entity will be positioned at the
@@ -27,16 +28,20 @@ func NewItem(i item.Stack, pos mgl64.Vec3) *Item {
 	if i.Count() > i.MaxCount() {
 		i = i.Grow(i.Count() - i.MaxCount())
 	}
-	it := &Item{i: i, movementComputer: &movementComputer{
-		gravity:           0.04,
-		dragBeforeGravity: true,
-	}}
+	it := &Item{i: i, c: NewMovementComputer(0.04, true)}
 	it.pos.Store(pos)
 	it.velocity.Store(mgl64.Vec3{})
 
 	return it
 }
 
+// newItem creates an item entity holding the item stack passed at the position passed. It is used by the block
+// package to drop items without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newItem(i item.Stack, pos mgl64.Vec3) world.Entity {
+	return NewItem(i, pos)
+}
+
 // Item returns the item stack that the item entity holds.
 func (it *Item) Item() item.Stack {
 	return it.i
@@ -47,6 +52,11 @@ func (it *Item) Position() mgl64.Vec3 {
 	return it.pos.Load().(mgl64.Vec3)
 }
 
+// OnGround checks if the item entity is currently on the ground.
+func (it *Item) OnGround() bool {
+	return it.c.OnGround()
+}
+
 // World returns the world that the item entity is currently in, or nil if it is not added to a world.
 func (it *Item) World() *world.World {
 	w, _ := world.OfEntity(it)
@@ -63,7 +73,7 @@ func (it *Item) Tick(current int64) {
 		_ = it.Close()
 		return
 	}
-	it.pos.Store(it.tickMovement(it))
+	it.pos.Store(it.c.TickMovement(it))
 	it.checkNearby()
 }
 
@@ -164,6 +174,35 @@ func (it *Item) State() []state.State {
 	return nil
 }
 
+// EncodeEntity ...
+func (it *Item) EncodeEntity() string {
+	return "minecraft:item"
+}
+
+// DecodeNBT decodes the properties in a map to an Item and returns a new Item entity.
+func (it *Item) DecodeNBT(data map[string]interface{}) interface{} {
+	itemData, _ := data["Item"].(map[string]interface{})
+	i := nbtconv.ItemFromNBT(itemData, nil)
+	if i.Empty() {
+		return nil
+	}
+	n := NewItem(i, nbtconv.MapVec3(data, "Pos"))
+	n.SetVelocity(nbtconv.MapVec3(data, "Motion"))
+	age, _ := data["Age"].(int16)
+	n.age = int(age)
+	return n
+}
+
+// EncodeNBT encodes the Item entity's properties as a map and returns it.
+func (it *Item) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"Age":    int16(it.age),
+		"Pos":    nbtconv.Vec3ToFloat32Slice(it.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(it.Velocity()),
+		"Item":   nbtconv.ItemToNBT(it.i, false),
+	}
+}
+
 // Close closes the item, removing it from the world that it is currently in.
 func (it *Item) Close() error {
 	it.World().RemoveEntity(it)
@@ -0,0 +1,119 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// Lightning is a lightning bolt that strikes the ground during thunderstorms. Entities close to the lightning
+// are damaged and set on fire, and the lightning may set the block it strikes on fire.
+type Lightning struct {
+	pos mgl64.Vec3
+	age int
+}
+
+// NewLightning creates a new lightning bolt that strikes at the position passed once added to a world.
+func NewLightning(pos mgl64.Vec3) *Lightning {
+	return &Lightning{pos: pos}
+}
+
+// newLightning creates a lightning bolt at the position passed. It is used by the world package to strike
+// lightning without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newLightning(pos mgl64.Vec3) world.Entity {
+	return NewLightning(pos)
+}
+
+// Position returns the position that the lightning strikes.
+func (l *Lightning) Position() mgl64.Vec3 {
+	return l.pos
+}
+
+// World returns the world that the lightning is currently in, or nil if it is not added to a world.
+func (l *Lightning) World() *world.World {
+	w, _ := world.OfEntity(l)
+	return w
+}
+
+// Tick ticks the lightning. In the first tick, the lightning strikes, damaging the entities around it and
+// setting the ground on fire. After that, the lightning is removed after a short moment.
+func (l *Lightning) Tick(int64) {
+	w := l.World()
+	if w == nil {
+		return
+	}
+	if l.age++; l.age == 1 {
+		l.strike(w)
+	}
+	if l.age >= 10 {
+		_ = l.Close()
+	}
+}
+
+// strike makes the lightning strike the world passed, damaging entities around it, setting them on fire and
+// placing fire at the position of the lightning.
+func (l *Lightning) strike(w *world.World) {
+	w.PlaySound(l.pos, sound.Thunder{})
+
+	for _, e := range w.EntitiesWithin(l.AABB().Translate(l.pos).Grow(3)) {
+		if living, ok := e.(Living); ok {
+			living.Hurt(5, damage.SourceLightning{})
+		}
+		if flammable, ok := e.(Flammable); ok && flammable.OnFireDuration() < time.Second*8 {
+			flammable.SetOnFire(time.Second * 8)
+		}
+	}
+
+	pos := world.BlockPosFromVec3(l.pos)
+	if _, ok := w.Block(pos).(block.Air); !ok {
+		return
+	}
+	below := pos.Side(world.FaceDown)
+	if len(boxes(w.Block(below), below, w)) != 0 {
+		w.PlaceBlock(pos, block.Fire{})
+	}
+}
+
+// OnGround always returns true.
+func (l *Lightning) OnGround() bool { return true }
+
+// Velocity always returns an empty velocity.
+func (l *Lightning) Velocity() mgl64.Vec3 { return mgl64.Vec3{} }
+
+// SetVelocity is a no-op: Lightning cannot move.
+func (l *Lightning) SetVelocity(mgl64.Vec3) {}
+
+// Yaw always returns 0.
+func (l *Lightning) Yaw() float64 { return 0 }
+
+// Pitch always returns 0.
+func (l *Lightning) Pitch() float64 { return 0 }
+
+// AABB ...
+func (l *Lightning) AABB() physics.AABB {
+	return physics.AABB{}
+}
+
+// State ...
+func (l *Lightning) State() []state.State {
+	return nil
+}
+
+// EncodeEntity ...
+func (l *Lightning) EncodeEntity() string {
+	return "minecraft:lightning_bolt"
+}
+
+// Close closes the lightning, removing it from the world that it is currently in.
+func (l *Lightning) Close() error {
+	if w := l.World(); w != nil {
+		w.RemoveEntity(l)
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/entity/healing"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
+	"time"
 )
 
 // Living represents an entity that is alive and that has health. It is able to take damage and will die upon
@@ -37,3 +38,14 @@ type Living interface {
 	// SetSpeed sets the speed of an entity to a new value.
 	SetSpeed(float64)
 }
+
+// Flammable represents an entity that may be set on fire, such as a player or a mob. Entities on fire take
+// damage every second until the fire is extinguished.
+type Flammable interface {
+	// OnFireDuration returns the duration that the entity remains on fire for. If the entity is not on fire,
+	// 0 is returned.
+	OnFireDuration() time.Duration
+	// SetOnFire sets the entity on fire for the duration passed. Passing a duration of 0 extinguishes the
+	// entity.
+	SetOnFire(duration time.Duration)
+}
@@ -0,0 +1,552 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/action"
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"github.com/df-mc/dragonfly/dragonfly/entity/healing"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/entity_internal"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/go-gl/mathgl/mgl64"
+	"go.uber.org/atomic"
+	"math"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// MobType represents a type of Mob, such as a Zombie or a Cow. The MobType of a mob specifies its properties
+// and its behaviour.
+type MobType interface {
+	// EncodeEntity returns the save ID of the mob type, such as 'minecraft:zombie'. It is also used to show
+	// the mob to viewers.
+	EncodeEntity() string
+	// AABB returns the AABB of mobs of this type.
+	AABB() physics.AABB
+	// EyeHeight returns the offset from the base position of the mob that its eyes are found at.
+	EyeHeight() float64
+	// MaxHealth returns the maximum health of mobs of this type.
+	MaxHealth() float64
+	// Speed returns the default movement speed of mobs of this type.
+	Speed() float64
+	// Drops returns the items that a mob of this type drops when it dies.
+	Drops() []item.Stack
+	// Goals returns the goals that make up the behaviour of a mob of this type, sorted by priority: The first
+	// goal returned has the highest priority. Goals may hold state, so Goals must return new goals every
+	// time it is called.
+	Goals() []Goal
+}
+
+// HostileMobType represents a MobType that may be hostile towards players, such as a Zombie. Players are not
+// able to sleep while hostile mobs are nearby.
+type HostileMobType interface {
+	MobType
+	// Hostile checks if mobs of this type are hostile towards players.
+	Hostile() bool
+}
+
+// Mob is a Living entity that is controlled by the server. Its behaviour is specified by the goals of its
+// MobType, which are able to move the mob around using pathfinding, look at other entities and attack them.
+type Mob struct {
+	t MobType
+
+	pos, velocity atomic.Value
+	yaw, pitch    atomic.Float64
+	speed         atomic.Float64
+	health        *entity_internal.HealthManager
+	immunity      atomic.Value
+	fireTicks     atomic.Int64
+
+	c        *MovementComputer
+	goals    *goalSelector
+	onGround atomic.Bool
+
+	mu sync.Mutex
+	// target is the entity that the mob is currently targeting, typically to attack it. attacker is the
+	// entity that last attacked the mob, and lastHurt the time at which the mob was last hurt.
+	target, attacker world.Entity
+	lastHurt         time.Time
+	// path is the path that the mob is currently following and pathSpeed the speed multiplier that it
+	// follows it at. stuckTicks is the amount of ticks that the mob has not made progress on the path.
+	path       []world.BlockPos
+	pathSpeed  float64
+	stuckTicks int
+	lookAt     *mgl64.Vec3
+	deathTicks int
+}
+
+// NewMob creates a new mob of the MobType passed at the position passed. The mob is not yet added to a world:
+// world.World.AddEntity must be called to do so.
+func NewMob(t MobType, pos mgl64.Vec3) *Mob {
+	m := &Mob{
+		t:      t,
+		health: entity_internal.NewHealthManager(),
+		c:      NewMovementComputer(0.08, false),
+		goals:  newGoalSelector(t.Goals()),
+		speed:  *atomic.NewFloat64(t.Speed()),
+	}
+	m.health.SetMaxHealth(t.MaxHealth())
+	m.health.AddHealth(t.MaxHealth())
+	m.pos.Store(pos)
+	m.velocity.Store(mgl64.Vec3{})
+	m.immunity.Store(time.Now())
+	return m
+}
+
+// Type returns the MobType of the mob.
+func (m *Mob) Type() MobType {
+	return m.t
+}
+
+// Position returns the current position of the mob.
+func (m *Mob) Position() mgl64.Vec3 {
+	return m.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the mob is currently in, or nil if it is not added to a world.
+func (m *Mob) World() *world.World {
+	w, _ := world.OfEntity(m)
+	return w
+}
+
+// Yaw returns the yaw of the mob in degrees.
+func (m *Mob) Yaw() float64 {
+	return m.yaw.Load()
+}
+
+// Pitch returns the pitch of the mob in degrees.
+func (m *Mob) Pitch() float64 {
+	return m.pitch.Load()
+}
+
+// Velocity returns the current velocity of the mob. The values in the Vec3 returned represent the speed on
+// that axis in blocks/tick.
+func (m *Mob) Velocity() mgl64.Vec3 {
+	return m.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the mob. The values in the Vec3 passed represent the speed on that axis in
+// blocks/tick.
+func (m *Mob) SetVelocity(v mgl64.Vec3) {
+	m.velocity.Store(v)
+}
+
+// OnGround checks if the mob is currently on the ground.
+func (m *Mob) OnGround() bool {
+	return m.onGround.Load()
+}
+
+// AABB returns the AABB of the mob, as specified by its MobType.
+func (m *Mob) AABB() physics.AABB {
+	return m.t.AABB()
+}
+
+// EyeHeight returns the offset from the base position of the mob that its eyes are found at.
+func (m *Mob) EyeHeight() float64 {
+	return m.t.EyeHeight()
+}
+
+// State ...
+func (m *Mob) State() []state.State {
+	if m.OnFireDuration() > 0 {
+		return []state.State{state.Breathing{}, state.OnFire{}}
+	}
+	return []state.State{state.Breathing{}}
+}
+
+// OnFireDuration returns the duration that the mob remains on fire for.
+func (m *Mob) OnFireDuration() time.Duration {
+	return time.Duration(m.fireTicks.Load()) * time.Second / 20
+}
+
+// SetOnFire sets the mob on fire for the duration passed. The mob takes fire damage every second while it is
+// on fire.
+func (m *Mob) SetOnFire(duration time.Duration) {
+	before := m.fireTicks.Swap(int64(duration.Seconds() * 20))
+	if (before > 0) != (duration > 0) {
+		m.updateState()
+	}
+}
+
+// updateState updates the state of the mob to all of its viewers.
+func (m *Mob) updateState() {
+	w := m.World()
+	if w == nil {
+		return
+	}
+	for _, v := range w.Viewers(m.Position()) {
+		v.ViewEntityState(m, m.State())
+	}
+}
+
+// Health returns the current health of the mob.
+func (m *Mob) Health() float64 {
+	return m.health.Health()
+}
+
+// MaxHealth returns the maximum health of the mob.
+func (m *Mob) MaxHealth() float64 {
+	return m.health.MaxHealth()
+}
+
+// SetMaxHealth sets the maximum health of the mob. If the current health of the mob is higher than the new
+// maximum health, the health is set to the new maximum.
+func (m *Mob) SetMaxHealth(v float64) {
+	m.health.SetMaxHealth(v)
+}
+
+// Dead checks if the mob is considered dead. True is returned if the health of the mob is equal to or lower
+// than 0.
+func (m *Mob) Dead() bool {
+	return m.Health() <= 0
+}
+
+// AttackImmune checks if the mob is currently immune to entity attacks, meaning it was recently attacked.
+func (m *Mob) AttackImmune() bool {
+	return m.immunity.Load().(time.Time).After(time.Now())
+}
+
+// Hurt hurts the mob for a given amount of damage. The source passed represents the cause of the damage. If
+// the damage exceeds the health of the mob, the mob is killed and drops the items of its MobType.
+// If the damage passed is negative, Hurt will not do anything.
+func (m *Mob) Hurt(dmg float64, source damage.Source) {
+	if m.Dead() || dmg < 0 {
+		return
+	}
+	m.health.AddHealth(-dmg)
+
+	m.mu.Lock()
+	m.lastHurt = time.Now()
+	switch src := source.(type) {
+	case damage.SourceEntityAttack:
+		m.attacker = src.Attacker
+	case damage.SourceProjectile:
+		if src.Owner != nil {
+			m.attacker = src.Owner
+		}
+	}
+	m.mu.Unlock()
+
+	w := m.World()
+	if w == nil {
+		return
+	}
+	for _, viewer := range w.Viewers(m.Position()) {
+		viewer.ViewEntityAction(m, action.Hurt{})
+	}
+	m.immunity.Store(time.Now().Add(time.Second / 2))
+	if m.Dead() {
+		m.kill(w)
+	}
+}
+
+// kill kills the mob, showing its death animation and dropping the items of its MobType. The mob is removed
+// from the world shortly after.
+func (m *Mob) kill(w *world.World) {
+	for _, viewer := range w.Viewers(m.Position()) {
+		viewer.ViewEntityAction(m, action.Death{})
+	}
+	if !w.GameRule(gamerule.DoMobLoot{}).(bool) {
+		return
+	}
+	for _, drop := range m.t.Drops() {
+		it := NewItem(drop, m.Position().Add(mgl64.Vec3{0, m.EyeHeight() / 2}))
+		it.SetVelocity(mgl64.Vec3{randFloat() * 0.2, 0.2, randFloat() * 0.2})
+		w.AddEntity(it)
+	}
+}
+
+// Heal heals the mob for a given amount of health. If the health added to the original health exceeds the
+// mob's max health, Heal will not add the full amount.
+// If the health passed is negative, Heal will not do anything.
+func (m *Mob) Heal(health float64, _ healing.Source) {
+	if m.Dead() || health < 0 {
+		return
+	}
+	m.health.AddHealth(health)
+}
+
+// KnockBack knocks the mob back with a given force and height. A source is passed which indicates the source
+// of the velocity, typically the position of an attacking entity. The source is used to calculate the
+// direction which the mob should be knocked back in.
+func (m *Mob) KnockBack(src mgl64.Vec3, force, height float64) {
+	if m.Dead() {
+		return
+	}
+	velocity := m.Position().Sub(src)
+	velocity[1] = 0
+	if velocity.Len() != 0 {
+		velocity = velocity.Normalize().Mul(force)
+	}
+	velocity[1] = height
+	m.SetVelocity(velocity)
+}
+
+// Speed returns the current movement speed of the mob.
+func (m *Mob) Speed() float64 {
+	return m.speed.Load()
+}
+
+// SetSpeed sets the movement speed of the mob to a new value.
+func (m *Mob) SetSpeed(v float64) {
+	m.speed.Store(v)
+}
+
+// Target returns the entity that the mob is currently targeting, if any. If the mob has no target, or if the
+// target is dead or no longer in the same world, false is returned.
+func (m *Mob) Target() (Living, bool) {
+	m.mu.Lock()
+	target := m.target
+	m.mu.Unlock()
+
+	l, ok := target.(Living)
+	if !ok || l.Health() <= 0 || l.World() != m.World() {
+		return nil, false
+	}
+	return l, true
+}
+
+// SetTarget sets the entity that the mob is targeting. Passing nil clears the target of the mob.
+func (m *Mob) SetTarget(e Living) {
+	m.mu.Lock()
+	m.target = e
+	m.mu.Unlock()
+}
+
+// Attacker returns the entity that last attacked the mob, if it attacked the mob within the duration passed.
+func (m *Mob) Attacker(within time.Duration) (world.Entity, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.attacker == nil || time.Since(m.lastHurt) > within {
+		return nil, false
+	}
+	return m.attacker, true
+}
+
+// HurtWithin checks if the mob was hurt by any source within the duration passed.
+func (m *Mob) HurtWithin(d time.Duration) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return !m.lastHurt.IsZero() && time.Since(m.lastHurt) <= d
+}
+
+// Navigate makes the mob find a path to the position passed and follow it, moving at its speed multiplied by
+// the speed multiplier passed. Navigate returns false if no path to the position could be found. If the
+// position itself cannot be reached, the mob moves to the closest position that can.
+func (m *Mob) Navigate(pos mgl64.Vec3, speed float64) bool {
+	w := m.World()
+	if w == nil {
+		return false
+	}
+	path, ok := FindPath(w, m.AABB(), world.BlockPosFromVec3(m.Position()), world.BlockPosFromVec3(pos))
+	if !ok || len(path) == 0 {
+		return false
+	}
+	m.mu.Lock()
+	m.path, m.pathSpeed, m.stuckTicks = path, speed, 0
+	m.mu.Unlock()
+	return true
+}
+
+// Navigating checks if the mob is currently following a path.
+func (m *Mob) Navigating() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.path) != 0
+}
+
+// StopNavigating stops the mob from following the path it is currently following.
+func (m *Mob) StopNavigating() {
+	m.mu.Lock()
+	m.path = nil
+	m.mu.Unlock()
+}
+
+// LookAt makes the mob look at the position passed during the next tick. LookAt must be called every tick for
+// the mob to keep looking at the position.
+func (m *Mob) LookAt(pos mgl64.Vec3) {
+	m.mu.Lock()
+	m.lookAt = &pos
+	m.mu.Unlock()
+}
+
+// SwingArm makes the mob swing its arm, typically when it attacks another entity.
+func (m *Mob) SwingArm() {
+	w := m.World()
+	if w == nil {
+		return
+	}
+	for _, viewer := range w.Viewers(m.Position()) {
+		viewer.ViewEntityAction(m, action.SwingArm{})
+	}
+}
+
+// Tick ticks the mob, executing its goals and performing movement.
+func (m *Mob) Tick(current int64) {
+	if m.Position()[1] < 0 && current%10 == 0 {
+		m.Hurt(4, damage.SourceVoid{})
+	}
+	if m.Dead() {
+		m.goals.stopAll(m)
+		if m.deathTicks++; m.deathTicks == 20 {
+			// The death animation has finished, so the mob may be removed.
+			_ = m.Close()
+		}
+		return
+	}
+	m.tickFire()
+	m.goals.tick(m)
+	m.tickMovement()
+}
+
+// tickFire ticks the fire that the mob is on, if any. Mobs on fire take damage every second, until the fire
+// runs out or the mob enters water.
+func (m *Mob) tickFire() {
+	ticks := m.fireTicks.Load()
+	if ticks <= 0 {
+		return
+	}
+	if _, ok := m.World().Block(world.BlockPosFromVec3(m.Position())).(block.Water); ok || ticks == 1 {
+		m.SetOnFire(0)
+		return
+	}
+	m.fireTicks.Store(ticks - 1)
+	if ticks%20 == 0 && m.World().GameRule(gamerule.FireDamage{}).(bool) {
+		m.Hurt(1, damage.SourceFire{})
+	}
+}
+
+// tickMovement moves the mob along the path that it is following, if any, and applies velocity, gravity and
+// friction to it.
+func (m *Mob) tickMovement() {
+	pos, velocity := m.Position(), m.Velocity()
+	yaw, pitch := m.Yaw(), m.Pitch()
+
+	m.mu.Lock()
+	direction, jump := m.followPath(pos)
+	speed := m.pathSpeed * m.Speed() * 0.45
+	lookAt := m.lookAt
+	m.lookAt = nil
+	m.mu.Unlock()
+
+	friction := 0.91
+	if m.c.OnGround() {
+		friction = 0.6
+	}
+	velocity = velocity.Add(direction.Mul(speed * (1 - friction)))
+	if jump && m.c.OnGround() {
+		velocity[1] = 0.42
+	}
+	if _, ok := m.World().Liquid(world.BlockPosFromVec3(pos)); ok {
+		// Mobs swim upwards in liquids, so that they do not drown.
+		velocity[1] += 0.1
+	}
+	m.SetVelocity(velocity)
+
+	newYaw, newPitch := yaw, pitch
+	if lookAt != nil {
+		newYaw, newPitch = rotationTowards(EyePosition(m), *lookAt)
+	} else if direction.Len() > 0 {
+		newYaw, _ = rotationTowards(pos, pos.Add(direction))
+		newPitch = 0
+	}
+
+	deltaPos := m.c.Step(m)
+	m.onGround.Store(m.c.OnGround())
+	if deltaPos.ApproxEqualThreshold(mgl64.Vec3{}, 0.001) && mgl64.FloatEqual(newYaw, yaw) && mgl64.FloatEqual(newPitch, pitch) {
+		return
+	}
+	for _, v := range m.World().Viewers(pos) {
+		v.ViewEntityMovement(m, deltaPos, newYaw-yaw, newPitch-pitch)
+	}
+	m.pos.Store(pos.Add(deltaPos))
+	m.yaw.Store(newYaw)
+	m.pitch.Store(newPitch)
+}
+
+// followPath returns the horizontal direction that the mob at the position passed should move in to follow
+// its current path, and whether it should jump. It advances the path once the mob reaches a node. m.mu must
+// be held when calling followPath.
+func (m *Mob) followPath(pos mgl64.Vec3) (direction mgl64.Vec3, jump bool) {
+	for len(m.path) != 0 {
+		node := m.path[0]
+		diff := node.Vec3Middle().Sub(pos)
+		diff[1] = float64(node[1]) - pos[1]
+
+		horizontal := mgl64.Vec3{diff[0], 0, diff[2]}
+		if horizontal.Len() < 0.35 && math.Abs(diff[1]) < 1 {
+			// The mob reached the node, so we move on to the next one.
+			m.path, m.stuckTicks = m.path[1:], 0
+			continue
+		}
+		if m.stuckTicks++; m.stuckTicks > 60 {
+			// The mob has not reached the next node for 3 seconds, meaning it is most likely stuck.
+			m.path = nil
+			break
+		}
+		if horizontal.Len() > 0.05 {
+			direction = horizontal.Normalize()
+		}
+		return direction, diff[1] > 0.5
+	}
+	return mgl64.Vec3{}, false
+}
+
+// randFloat returns a random float64 in the range [-1, 1).
+func randFloat() float64 {
+	return rand.Float64()*2 - 1
+}
+
+// rotationTowards returns the yaw and pitch that an entity at the position passed must have to look at the
+// target position passed.
+func rotationTowards(pos, target mgl64.Vec3) (yaw, pitch float64) {
+	diff := target.Sub(pos)
+	horizontal := math.Sqrt(diff[0]*diff[0] + diff[2]*diff[2])
+	yaw = mgl64.RadToDeg(math.Atan2(-diff[0], diff[2]))
+	pitch = mgl64.RadToDeg(-math.Atan2(diff[1], horizontal))
+	return yaw, pitch
+}
+
+// EncodeEntity ...
+func (m *Mob) EncodeEntity() string {
+	return m.t.EncodeEntity()
+}
+
+// DecodeNBT decodes the properties in a map to a Mob of the same MobType and returns it.
+func (m *Mob) DecodeNBT(data map[string]interface{}) interface{} {
+	n := NewMob(m.t, nbtconv.MapVec3(data, "Pos"))
+	n.SetVelocity(nbtconv.MapVec3(data, "Motion"))
+	if rot, _ := data["Rotation"].([]interface{}); len(rot) == 2 {
+		yaw, _ := rot[0].(float32)
+		pitch, _ := rot[1].(float32)
+		n.yaw.Store(float64(yaw))
+		n.pitch.Store(float64(pitch))
+	}
+	if health, ok := data["Health"].(float32); ok && health > 0 {
+		n.health.AddHealth(float64(health) - n.Health())
+	}
+	return n
+}
+
+// EncodeNBT encodes the Mob's properties as a map and returns it.
+func (m *Mob) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"Pos":      nbtconv.Vec3ToFloat32Slice(m.Position()),
+		"Motion":   nbtconv.Vec3ToFloat32Slice(m.Velocity()),
+		"Rotation": []float32{float32(m.Yaw()), float32(m.Pitch())},
+		"Health":   float32(m.Health()),
+	}
+}
+
+// Close closes the mob, removing it from the world that it is currently in.
+func (m *Mob) Close() error {
+	if w := m.World(); w != nil {
+		w.RemoveEntity(m)
+	}
+	return nil
+}
@@ -16,30 +16,40 @@ func boxes(b world.Block, pos world.BlockPos, w *world.World) []physics.AABB {
 	return []physics.AABB{physics.NewAABB(mgl64.Vec3{}, mgl64.Vec3{1, 1, 1})}
 }
 
-// movementComputer is used to compute movement of an entity. When constructed, the gravity of the entity
+// MovementComputer is used to compute movement of an entity. When constructed, the gravity of the entity
 // the movement is computed for must be passed.
-type movementComputer struct {
+type MovementComputer struct {
 	onGround          bool
 	gravity           float64
 	dragBeforeGravity bool
 }
 
-// tickMovement performs a movement tick on an entity. Velocity is applied and changed according to the values
+// NewMovementComputer creates a new MovementComputer for an entity with the gravity passed. If
+// dragBeforeGravity is true, drag is applied to the vertical velocity of the entity before gravity is.
+func NewMovementComputer(gravity float64, dragBeforeGravity bool) *MovementComputer {
+	return &MovementComputer{gravity: gravity, dragBeforeGravity: dragBeforeGravity}
+}
+
+// TickMovement performs a movement tick on an entity. Velocity is applied and changed according to the values
 // of its drag and gravity.
 // The new position of the entity after movement is returned.
-func (c *movementComputer) tickMovement(e world.Entity) mgl64.Vec3 {
-	toMove, velocity := c.handleCollision(e)
+func (c *MovementComputer) TickMovement(e world.Entity) mgl64.Vec3 {
+	return c.move(e, c.Step(e))
+}
+
+// Step computes the movement of an entity for a single tick without moving it. The velocity of the entity is
+// changed according to the values of its drag and gravity, and the distance the entity should move after
+// colliding with blocks is returned.
+func (c *MovementComputer) Step(e world.Entity) mgl64.Vec3 {
+	toMove, velocity := c.Collide(e, e.Velocity())
 	e.SetVelocity(velocity)
-	v := c.move(e, toMove)
-	e.SetVelocity(c.applyGravity(e))
-	e.SetVelocity(c.applyFriction(e))
-	return v
+	e.SetVelocity(c.ApplyFriction(c.ApplyGravity(e.Velocity())))
+	return toMove
 }
 
-// applyGravity applies gravity to the entity's velocity. By default, 0.08 is subtracted from the y value, or
-// a different value if the Gravity
-func (c *movementComputer) applyGravity(e world.Entity) mgl64.Vec3 {
-	velocity := e.Velocity()
+// ApplyGravity applies gravity to the velocity passed and returns it. By default, 0.08 is subtracted from
+// the y value, or a different value if the gravity of the MovementComputer is different.
+func (c *MovementComputer) ApplyGravity(velocity mgl64.Vec3) mgl64.Vec3 {
 	if c.dragBeforeGravity {
 		velocity[1] *= 0.98
 	}
@@ -50,9 +60,9 @@ func (c *movementComputer) applyGravity(e world.Entity) mgl64.Vec3 {
 	return velocity
 }
 
-// applyFriction applies friction to the entity, reducing its velocity on the X and Z axes.
-func (c *movementComputer) applyFriction(e world.Entity) mgl64.Vec3 {
-	velocity := e.Velocity()
+// ApplyFriction applies friction to the velocity passed, reducing it on the X and Z axes. The friction
+// applied depends on whether the entity is currently on the ground.
+func (c *MovementComputer) ApplyFriction(velocity mgl64.Vec3) mgl64.Vec3 {
 	if c.onGround {
 		velocity[0] *= 0.6
 		velocity[2] *= 0.6
@@ -64,7 +74,7 @@ func (c *movementComputer) applyFriction(e world.Entity) mgl64.Vec3 {
 }
 
 // move moves the entity so that all viewers in the world can see it, adding the velocity to the position.
-func (c *movementComputer) move(e world.Entity, deltaPos mgl64.Vec3) mgl64.Vec3 {
+func (c *MovementComputer) move(e world.Entity, deltaPos mgl64.Vec3) mgl64.Vec3 {
 	if deltaPos.ApproxEqualThreshold(mgl64.Vec3{}, 0.01) {
 		return e.Position()
 	}
@@ -74,12 +84,11 @@ func (c *movementComputer) move(e world.Entity, deltaPos mgl64.Vec3) mgl64.Vec3
 	return e.Position().Add(deltaPos)
 }
 
-// handleCollision handles the collision of the entity with blocks, adapting the velocity of the entity if it
-// happens to collide with a block.
+// Collide handles the collision of the entity with blocks when it moves with the velocity passed, adapting
+// the velocity if it happens to collide with a block.
 // The final velocity and the Vec3 that the entity should move is returned.
-func (c *movementComputer) handleCollision(e world.Entity) (move mgl64.Vec3, velocity mgl64.Vec3) {
+func (c *MovementComputer) Collide(e world.Entity, velocity mgl64.Vec3) (move mgl64.Vec3, newVelocity mgl64.Vec3) {
 	// TODO: Implement collision with other entities.
-	velocity = e.Velocity()
 	deltaX, deltaY, deltaZ := velocity[0], velocity[1], velocity[2]
 
 	// Entities only ever have a single bounding box.
@@ -106,7 +115,7 @@ func (c *movementComputer) handleCollision(e world.Entity) (move mgl64.Vec3, vel
 			deltaZ = entityAABB.CalculateZOffset(blockAABB, deltaZ)
 		}
 	}
-	if !mgl64.FloatEqual(velocity[0], 0) {
+	if !mgl64.FloatEqual(velocity[1], 0) {
 		// The Y velocity of the entity is currently not 0, meaning it is moving either up or down. We can
 		// then assume the entity is not currently on the ground.
 		c.onGround = false
@@ -154,6 +163,6 @@ func blockAABBsAround(e world.Entity, aabb physics.AABB) []physics.AABB {
 }
 
 // OnGround checks if the entity that this computer calculates is currently on the ground.
-func (c *movementComputer) OnGround() bool {
+func (c *MovementComputer) OnGround() bool {
 	return c.onGround
 }
@@ -0,0 +1,250 @@
+package entity
+
+import (
+	"container/heap"
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+const (
+	// maxFallDistance is the maximum amount of blocks that an entity following a path will drop down.
+	maxFallDistance = 3
+	// maxPathNodes is the maximum amount of nodes that FindPath visits before giving up on finding a path.
+	maxPathNodes = 800
+)
+
+// FindPath finds a path for an entity with the AABB passed from the start position to the end position in
+// the world passed, using the A* algorithm. The path only consists of positions that the entity is able to
+// stand on without colliding with any blocks. Entities following the path may step up a single block and drop
+// down at most three blocks at a time.
+// The path returned starts with the first position after the start position and ends with the end position.
+// If no path could be found, the bool returned is false. If the end position itself could not be reached, the
+// path returned leads to the position closest to the end that could be reached.
+func FindPath(w *world.World, aabb physics.AABB, start, end world.BlockPos) ([]world.BlockPos, bool) {
+	f := pathFinder{w: w, aabb: aabb, end: end, nodes: map[world.BlockPos]*pathNode{}}
+	return f.find(start)
+}
+
+// pathFinder holds the state of a single call to FindPath.
+type pathFinder struct {
+	w    *world.World
+	aabb physics.AABB
+	end  world.BlockPos
+
+	nodes map[world.BlockPos]*pathNode
+	open  pathQueue
+}
+
+// pathNode is a single node in the graph searched by the pathFinder.
+type pathNode struct {
+	pos world.BlockPos
+	// cost is the cost to reach the node from the start position, and estimate the estimated total cost of a
+	// path through the node.
+	cost, estimate float64
+	parent         *pathNode
+	closed         bool
+	index          int
+}
+
+// find finds a path from the start position passed to the end position of the pathFinder.
+func (f *pathFinder) find(start world.BlockPos) ([]world.BlockPos, bool) {
+	first := &pathNode{pos: start, estimate: f.heuristic(start)}
+	f.nodes[start] = first
+	heap.Push(&f.open, first)
+
+	closest := first
+	for visited := 0; f.open.Len() > 0 && visited < maxPathNodes; visited++ {
+		n := heap.Pop(&f.open).(*pathNode)
+		if n.pos == f.end {
+			return f.path(n), true
+		}
+		n.closed = true
+		if f.heuristic(n.pos) < f.heuristic(closest.pos) {
+			closest = n
+		}
+		for _, neighbour := range f.neighbours(n.pos) {
+			cost := n.cost + distance(n.pos, neighbour)
+			if neighbour[1] > n.pos[1] {
+				// Jumping up is slightly more expensive than walking.
+				cost += 0.5
+			}
+			other, ok := f.nodes[neighbour]
+			if !ok {
+				other = &pathNode{pos: neighbour, cost: cost, estimate: cost + f.heuristic(neighbour), parent: n}
+				f.nodes[neighbour] = other
+				heap.Push(&f.open, other)
+				continue
+			}
+			if other.closed || cost >= other.cost {
+				continue
+			}
+			other.cost, other.estimate, other.parent = cost, cost+f.heuristic(neighbour), n
+			heap.Fix(&f.open, other.index)
+		}
+	}
+	if closest == first {
+		return nil, false
+	}
+	return f.path(closest), true
+}
+
+// path reconstructs the path ending in the node passed.
+func (f *pathFinder) path(n *pathNode) []world.BlockPos {
+	var path []world.BlockPos
+	for ; n.parent != nil; n = n.parent {
+		path = append(path, n.pos)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// heuristic returns the estimated cost of a path from the position passed to the end position.
+func (f *pathFinder) heuristic(pos world.BlockPos) float64 {
+	return distance(pos, f.end)
+}
+
+// neighbours returns all positions directly next to the position passed that an entity standing on it is
+// able to move to.
+func (f *pathFinder) neighbours(pos world.BlockPos) []world.BlockPos {
+	neighbours := make([]world.BlockPos, 0, 8)
+	walkable := map[world.Direction]bool{}
+	for _, dir := range []world.Direction{world.North, world.East, world.South, world.West} {
+		side := pos.Side(dir.Face())
+		if target, ok := f.walkableFrom(pos, side); ok {
+			neighbours = append(neighbours, target)
+			walkable[dir] = target == side
+		}
+	}
+	// Diagonal movement is only allowed if both sides next to it are free on the same level, so that the
+	// entity does not cut corners.
+	for _, dirs := range [][2]world.Direction{{world.North, world.East}, {world.East, world.South}, {world.South, world.West}, {world.West, world.North}} {
+		if !walkable[dirs[0]] || !walkable[dirs[1]] {
+			continue
+		}
+		diagonal := pos.Side(dirs[0].Face()).Side(dirs[1].Face())
+		if f.standable(diagonal) {
+			neighbours = append(neighbours, diagonal)
+		}
+	}
+	return neighbours
+}
+
+// walkableFrom checks if an entity standing on the position passed could move to the side passed, either by
+// walking, jumping up a block or dropping down. The position that the entity would end up at is returned.
+func (f *pathFinder) walkableFrom(pos, side world.BlockPos) (world.BlockPos, bool) {
+	if f.standable(side) {
+		return side, true
+	}
+	if up := side.Add(world.BlockPos{0, 1}); f.passable(pos.Add(world.BlockPos{0, 1})) && f.standable(up) {
+		// The entity can jump onto the block next to it.
+		return up, true
+	}
+	if !f.passable(side) {
+		return side, false
+	}
+	for y := 1; y <= maxFallDistance; y++ {
+		down := side.Add(world.BlockPos{0, -y})
+		if !f.passable(down) {
+			return down, false
+		}
+		if f.standable(down) {
+			return down, true
+		}
+	}
+	return side, false
+}
+
+// standable checks if an entity is able to stand at the position passed: The entity must fit in the space
+// at the position and there must be a block below it to stand on.
+func (f *pathFinder) standable(pos world.BlockPos) bool {
+	if pos[1] < 0 || pos[1] > 255 || !f.passable(pos) {
+		return false
+	}
+	if _, ok := f.w.Liquid(pos); ok {
+		// Entities may swim in water, so we allow paths through it.
+		return true
+	}
+	box := f.box(pos).Translate(mgl64.Vec3{0, -0.1})
+	return physics.AnyIntersections(f.blockBoxes(box), box)
+}
+
+// passable checks if an entity could occupy the position passed without colliding with any blocks or
+// walking into lava.
+func (f *pathFinder) passable(pos world.BlockPos) bool {
+	box := f.box(pos)
+	min, max := box.Min(), box.Max()
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+			for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+				if liquid, ok := f.w.Liquid(world.BlockPos{x, y, z}); ok {
+					if _, lava := liquid.(block.Lava); lava {
+						return false
+					}
+				}
+			}
+		}
+	}
+	return !physics.AnyIntersections(f.blockBoxes(box), box)
+}
+
+// box returns the AABB of the entity when standing in the middle of the position passed.
+func (f *pathFinder) box(pos world.BlockPos) physics.AABB {
+	return f.aabb.Translate(mgl64.Vec3{float64(pos[0]) + 0.5, float64(pos[1]), float64(pos[2]) + 0.5})
+}
+
+// blockBoxes returns the AABBs of all blocks that touch the AABB passed.
+func (f *pathFinder) blockBoxes(aabb physics.AABB) []physics.AABB {
+	min, max := aabb.Min(), aabb.Max()
+	var blockBoxes []physics.AABB
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+			for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+				pos := world.BlockPos{x, y, z}
+				for _, box := range boxes(f.w.Block(pos), pos, f.w) {
+					blockBoxes = append(blockBoxes, box.Translate(mgl64.Vec3{float64(x), float64(y), float64(z)}))
+				}
+			}
+		}
+	}
+	return blockBoxes
+}
+
+// distance returns the distance between two block positions.
+func distance(a, b world.BlockPos) float64 {
+	return a.Vec3().Sub(b.Vec3()).Len()
+}
+
+// pathQueue is a priority queue of path nodes, ordered by the estimated cost of each node.
+type pathQueue []*pathNode
+
+// Len ...
+func (q pathQueue) Len() int { return len(q) }
+
+// Less ...
+func (q pathQueue) Less(i, j int) bool { return q[i].estimate < q[j].estimate }
+
+// Swap ...
+func (q pathQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index, q[j].index = i, j
+}
+
+// Push ...
+func (q *pathQueue) Push(x interface{}) {
+	n := x.(*pathNode)
+	n.index = len(*q)
+	*q = append(*q, n)
+}
+
+// Pop ...
+func (q *pathQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
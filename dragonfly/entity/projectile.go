@@ -0,0 +1,198 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/damage"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/event"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+)
+
+// Projectile represents an entity that is launched by another entity, such as an arrow shot by a player or a
+// snowball thrown by one.
+type Projectile interface {
+	world.Entity
+	// Owner returns the entity that launched the projectile. Owner returns nil if the projectile was not
+	// launched by an entity.
+	Owner() world.Entity
+}
+
+// projectileComputer is used to compute the movement of projectiles. Rather than colliding with blocks like
+// other entities, projectiles trace the path they travel every tick, so that they hit blocks and entities
+// even at high speeds.
+type projectileComputer struct {
+	gravity, drag float64
+	age           int
+}
+
+// projectileHit is the result of a projectile colliding with a block or an entity.
+type projectileHit struct {
+	// pos is the exact position at which the projectile hit the block or entity.
+	pos mgl64.Vec3
+	// entity is the entity that was hit. If a block was hit, entity is nil and blockPos and face hold the
+	// position of the block and the face of the block that was hit.
+	entity   world.Entity
+	blockPos world.BlockPos
+	face     world.Face
+}
+
+// newProjectileComputer returns a projectile computer for a projectile with the gravity and drag passed.
+func newProjectileComputer(gravity, drag float64) *projectileComputer {
+	return &projectileComputer{gravity: gravity, drag: drag}
+}
+
+// tickMovement performs a movement tick on the projectile passed. The new position of the projectile is
+// returned, along with the block or entity hit during the tick, if any. If something was hit, the position
+// returned is the position of the hit and the velocity of the projectile remains unchanged.
+func (c *projectileComputer) tickMovement(e Projectile) (mgl64.Vec3, *projectileHit) {
+	c.age++
+	pos, velocity := e.Position(), e.Velocity()
+	end := pos.Add(velocity)
+
+	hit := c.trace(e, pos, end)
+	if hit != nil {
+		end = hit.pos
+	} else {
+		velocity = velocity.Mul(1 - c.drag)
+		velocity[1] -= c.gravity
+		e.SetVelocity(velocity)
+	}
+	deltaPos := end.Sub(pos)
+	if !deltaPos.ApproxEqualThreshold(mgl64.Vec3{}, 0.001) {
+		for _, v := range e.World().Viewers(pos) {
+			v.ViewEntityMovement(e, deltaPos, 0, 0)
+		}
+	}
+	return end, hit
+}
+
+// trace traces the path of the projectile passed from start to end, returning the first block or entity
+// that is hit on the path. If nothing is hit, nil is returned.
+func (c *projectileComputer) trace(e Projectile, start, end mgl64.Vec3) *projectileHit {
+	w := e.World()
+	var hit *projectileHit
+	nearest := math.MaxFloat64
+
+	box := physics.NewAABB(start, start).Extend(end.Sub(start))
+	min, max := box.Min(), box.Max()
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+			for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+				pos := world.BlockPos{x, y, z}
+				for _, blockBox := range boxes(w.Block(pos), pos, w) {
+					t, face, ok := intercept(blockBox.Translate(pos.Vec3()), start, end)
+					if ok && t < nearest {
+						nearest = t
+						hit = &projectileHit{pos: lerpVec3(start, end, t), blockPos: pos, face: face}
+					}
+				}
+			}
+		}
+	}
+
+	for _, other := range w.EntitiesWithin(box.Grow(2)) {
+		if other == e {
+			continue
+		}
+		if _, ok := other.(Living); !ok {
+			// Only living entities, such as players and mobs, may be hit by projectiles.
+			continue
+		}
+		if other == e.Owner() && c.age < 5 {
+			// Don't allow projectiles to hit their owner immediately after being launched.
+			continue
+		}
+		t, _, ok := intercept(other.AABB().Translate(other.Position()).Grow(0.3), start, end)
+		if ok && t < nearest {
+			nearest = t
+			hit = &projectileHit{pos: lerpVec3(start, end, t), entity: other}
+		}
+	}
+	return hit
+}
+
+// hitEvent calls the event of the projectile passed hitting a block or an entity on the Handler of its world.
+// hitEvent returns false if the event was cancelled.
+func (c *projectileComputer) hitEvent(e Projectile, hit *projectileHit) bool {
+	ctx := event.C()
+	if hit.entity != nil {
+		e.World().Handler().HandleProjectileHitEntity(ctx, e, hit.entity)
+	} else {
+		e.World().Handler().HandleProjectileHitBlock(ctx, e, hit.blockPos, hit.face)
+	}
+	applied := false
+	ctx.Continue(func() {
+		applied = true
+	})
+	return applied
+}
+
+// hurt hurts the entity hit by the projectile passed with the damage passed, provided it is a Living entity
+// that is not immune to attacks, and knocks it back in the direction that the projectile was moving. hurt
+// returns false if the entity was not hurt.
+func (c *projectileComputer) hurt(e Projectile, hit *projectileHit, dmg, force float64) bool {
+	living, ok := hit.entity.(Living)
+	if !ok || living.AttackImmune() {
+		return false
+	}
+	living.Hurt(dmg, damage.SourceProjectile{Projectile: e, Owner: e.Owner()})
+	living.KnockBack(living.Position().Sub(e.Velocity()), force, 0.3608)
+	return true
+}
+
+// intercept calculates where the line from start to end intercepts the AABB passed. The fraction of the line
+// at which the AABB is hit is returned, along with the face of the AABB that was hit. If the line does not
+// intercept the AABB, false is returned. If start is within the AABB, 0 is returned.
+func intercept(aabb physics.AABB, start, end mgl64.Vec3) (t float64, face world.Face, ok bool) {
+	min, max, dir := aabb.Min(), aabb.Max(), end.Sub(start)
+	enter, exit := math.Inf(-1), math.Inf(1)
+	for axis := 0; axis < 3; axis++ {
+		if dir[axis] == 0 {
+			if start[axis] <= min[axis] || start[axis] >= max[axis] {
+				return 0, 0, false
+			}
+			continue
+		}
+		t1, t2 := (min[axis]-start[axis])/dir[axis], (max[axis]-start[axis])/dir[axis]
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		if t1 > enter {
+			enter, face = t1, axisFace(axis, dir[axis] > 0)
+		}
+		if t2 < exit {
+			exit = t2
+		}
+	}
+	if enter > exit || exit < 0 || enter > 1 {
+		return 0, 0, false
+	}
+	return math.Max(enter, 0), face, true
+}
+
+// axisFace returns the face of a box that is hit first when moving along the axis passed, either in the
+// positive direction or in the negative direction.
+func axisFace(axis int, positive bool) world.Face {
+	switch axis {
+	case 0:
+		if positive {
+			return world.FaceWest
+		}
+		return world.FaceEast
+	case 1:
+		if positive {
+			return world.FaceDown
+		}
+		return world.FaceUp
+	}
+	if positive {
+		return world.FaceNorth
+	}
+	return world.FaceSouth
+}
+
+// lerpVec3 linearly interpolates between a and b using the fraction t.
+func lerpVec3(a, b mgl64.Vec3, t float64) mgl64.Vec3 {
+	return a.Add(b.Sub(a).Mul(t))
+}
@@ -0,0 +1,17 @@
+package entity
+
+import "github.com/df-mc/dragonfly/dragonfly/world"
+
+// init registers all entities implemented by Dragonfly that may be saved to a world.
+func init() {
+	world.RegisterEntity(&Item{})
+	world.RegisterEntity(&Mob{t: Zombie{}})
+	world.RegisterEntity(&Mob{t: Cow{}})
+	world.RegisterEntity(&Mob{t: Chicken{}})
+	world.RegisterEntity(&Arrow{})
+	world.RegisterEntity(&Snowball{})
+	world.RegisterEntity(&Egg{})
+	world.RegisterEntity(&EnderPearl{})
+	world.RegisterEntity(&TNT{})
+	world.RegisterEntity(&FallingBlock{})
+}
@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"go.uber.org/atomic"
+)
+
+// Snowball is a throwable projectile which damages entities it hits by 0 points, knocking them back slightly.
+type Snowball struct {
+	pos, velocity atomic.Value
+	owner         world.Entity
+
+	c *projectileComputer
+}
+
+// NewSnowball creates a new snowball at the position passed, moving with the velocity passed. The owner is
+// the entity that threw the snowball, or nil if it was not thrown by an entity.
+func NewSnowball(pos, velocity mgl64.Vec3, owner world.Entity) *Snowball {
+	s := &Snowball{owner: owner, c: newProjectileComputer(0.03, 0.01)}
+	s.pos.Store(pos)
+	s.velocity.Store(velocity)
+	return s
+}
+
+// newSnowball creates a snowball thrown by the owner passed. It is used by the item package to launch
+// snowballs without importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newSnowball(owner world.Entity) world.Entity {
+	return NewSnowball(EyePosition(owner), DirectionVector(owner).Mul(1.5), owner)
+}
+
+// Owner returns the entity that threw the snowball.
+func (s *Snowball) Owner() world.Entity {
+	return s.owner
+}
+
+// Position returns the current position of the snowball.
+func (s *Snowball) Position() mgl64.Vec3 {
+	return s.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the snowball is currently in, or nil if it is not added to a world.
+func (s *Snowball) World() *world.World {
+	w, _ := world.OfEntity(s)
+	return w
+}
+
+// Tick ticks the snowball, moving it and checking if it hit a block or an entity.
+func (s *Snowball) Tick(current int64) {
+	pos, hit := s.c.tickMovement(s)
+	s.pos.Store(pos)
+	if hit == nil {
+		if pos[1] < 0 && current%10 == 0 {
+			_ = s.Close()
+		}
+		return
+	}
+	if s.c.hitEvent(s, hit) && hit.entity != nil {
+		s.c.hurt(s, hit, 0, 0.4)
+	}
+	_ = s.Close()
+}
+
+// Velocity returns the current velocity of the snowball.
+func (s *Snowball) Velocity() mgl64.Vec3 {
+	return s.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the snowball.
+func (s *Snowball) SetVelocity(v mgl64.Vec3) {
+	s.velocity.Store(v)
+}
+
+// OnGround always returns false.
+func (s *Snowball) OnGround() bool { return false }
+
+// Yaw always returns 0.
+func (s *Snowball) Yaw() float64 { return 0 }
+
+// Pitch always returns 0.
+func (s *Snowball) Pitch() float64 { return 0 }
+
+// AABB ...
+func (s *Snowball) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.125, 0, -0.125}, mgl64.Vec3{0.125, 0.25, 0.125})
+}
+
+// State ...
+func (s *Snowball) State() []state.State {
+	return nil
+}
+
+// EncodeEntity ...
+func (s *Snowball) EncodeEntity() string {
+	return "minecraft:snowball"
+}
+
+// DecodeNBT decodes the properties in a map to a Snowball and returns a new Snowball entity.
+func (s *Snowball) DecodeNBT(data map[string]interface{}) interface{} {
+	return NewSnowball(nbtconv.MapVec3(data, "Pos"), nbtconv.MapVec3(data, "Motion"), nil)
+}
+
+// EncodeNBT encodes the Snowball entity's properties as a map and returns it.
+func (s *Snowball) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"Pos":    nbtconv.Vec3ToFloat32Slice(s.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(s.Velocity()),
+	}
+}
+
+// Close closes the snowball, removing it from the world that it is currently in.
+func (s *Snowball) Close() error {
+	if w := s.World(); w != nil {
+		w.RemoveEntity(s)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package state
 
-import "image/color"
+import (
+	"image/color"
+	"time"
+)
 
 // State represents a part of the state of an entity. Entities may hold a combination of these to indicate
 // things such as whether it is sprinting or on fire.
@@ -21,9 +24,28 @@ type Swimming struct{}
 // Breathing makes an entity breath: This state will not show up for entities other than players.
 type Breathing struct{}
 
+// AirSupply makes an entity show the air supply it has left. For players, the air supply is displayed as
+// bubbles above the hotbar while underwater.
+type AirSupply struct {
+	// Remaining is the air supply that the entity has left.
+	Remaining time.Duration
+	// Max is the maximum air supply of the entity.
+	Max time.Duration
+}
+
 // Invisible makes an entity invisible, so that other players won't be able to see it.
 type Invisible struct{}
 
+// OnFire makes an entity show up as if it is on fire.
+type OnFire struct{}
+
+// Ignited makes an entity, such as TNT, show up as if it was ignited. The entity will flash until its fuse
+// runs out.
+type Ignited struct {
+	// Fuse is the time left until the entity explodes.
+	Fuse time.Duration
+}
+
 // EffectBearing makes an entity show up as if it is bearing effects. Coloured particles will be shown around
 // the player.
 type EffectBearing struct {
@@ -34,6 +56,12 @@ type EffectBearing struct {
 	Ambient bool
 }
 
+// Sleeping makes an entity show up as if it is sleeping in a bed.
+type Sleeping struct {
+	// BedPosition is the block position of the head of the bed that the entity is sleeping in.
+	BedPosition [3]int
+}
+
 // Named makes an entity show a specific name tag above it.
 type Named struct {
 	// NameTag is the name displayed. This name may have colour codes, newlines etc in it, much like a normal
@@ -44,7 +72,11 @@ type Named struct {
 func (Sneaking) __()      {}
 func (Swimming) __()      {}
 func (Breathing) __()     {}
+func (AirSupply) __()     {}
 func (Sprinting) __()     {}
 func (Invisible) __()     {}
+func (OnFire) __()        {}
+func (Ignited) __()       {}
 func (Named) __()         {}
 func (EffectBearing) __() {}
+func (Sleeping) __()      {}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/entity/state"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+	"sync/atomic"
+	"time"
+)
+
+// TNT represents primed TNT, which explodes once its fuse runs out. Primed TNT is affected by gravity and may
+// be knocked around by other explosions.
+type TNT struct {
+	velocity, pos atomic.Value
+	fuse          int64
+
+	c *MovementComputer
+}
+
+// NewTNT creates a new primed TNT entity at the position passed. The TNT explodes once the fuse passed runs
+// out. Vanilla TNT ignited by a player has a fuse of 4 seconds.
+func NewTNT(pos mgl64.Vec3, fuse time.Duration) *TNT {
+	t := &TNT{fuse: fuse.Milliseconds() / 50, c: NewMovementComputer(0.04, true)}
+	t.pos.Store(pos)
+	t.velocity.Store(mgl64.Vec3{})
+	return t
+}
+
+// newTNT creates primed TNT at the position passed. It is used by the block package to ignite TNT without
+// importing the entity package.
+//lint:ignore U1000 Function is used through compiler directives.
+func newTNT(pos mgl64.Vec3, fuse time.Duration) world.Entity {
+	return NewTNT(pos, fuse)
+}
+
+// Fuse returns the time left until the TNT explodes.
+func (t *TNT) Fuse() time.Duration {
+	return time.Duration(atomic.LoadInt64(&t.fuse)) * time.Second / 20
+}
+
+// Position returns the current position of the TNT.
+func (t *TNT) Position() mgl64.Vec3 {
+	return t.pos.Load().(mgl64.Vec3)
+}
+
+// World returns the world that the TNT is currently in, or nil if it is not added to a world.
+func (t *TNT) World() *world.World {
+	w, _ := world.OfEntity(t)
+	return w
+}
+
+// OnGround checks if the TNT is currently on the ground.
+func (t *TNT) OnGround() bool {
+	return t.c.OnGround()
+}
+
+// Tick ticks the TNT, moving it and making it explode once its fuse runs out.
+func (t *TNT) Tick(current int64) {
+	w := t.World()
+	if w == nil {
+		return
+	}
+	if t.Position()[1] < 0 && current%10 == 0 {
+		_ = t.Close()
+		return
+	}
+	t.pos.Store(t.c.TickMovement(t))
+
+	if atomic.AddInt64(&t.fuse, -1) <= 0 {
+		_ = t.Close()
+		w.Explode(t.Position().Add(mgl64.Vec3{0, 0.0625}), 4, world.ExplosionConfig{Source: t})
+	}
+}
+
+// Velocity returns the current velocity of the TNT. The values in the Vec3 returned represent the speed on
+// that axis in blocks/tick.
+func (t *TNT) Velocity() mgl64.Vec3 {
+	return t.velocity.Load().(mgl64.Vec3)
+}
+
+// SetVelocity sets the velocity of the TNT. The values in the Vec3 passed represent the speed on that axis
+// in blocks/tick.
+func (t *TNT) SetVelocity(v mgl64.Vec3) {
+	t.velocity.Store(v)
+}
+
+// Yaw always returns 0.
+func (t *TNT) Yaw() float64 { return 0 }
+
+// Pitch always returns 0.
+func (t *TNT) Pitch() float64 { return 0 }
+
+// AABB ...
+func (t *TNT) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.49, 0, -0.49}, mgl64.Vec3{0.49, 0.98, 0.49})
+}
+
+// State ...
+func (t *TNT) State() []state.State {
+	return []state.State{state.Ignited{Fuse: t.Fuse()}}
+}
+
+// EncodeEntity ...
+func (t *TNT) EncodeEntity() string {
+	return "minecraft:tnt"
+}
+
+// DecodeNBT decodes the properties in a map to a TNT and returns a new TNT entity.
+func (t *TNT) DecodeNBT(data map[string]interface{}) interface{} {
+	fuse, _ := data["Fuse"].(uint8)
+	n := NewTNT(nbtconv.MapVec3(data, "Pos"), time.Duration(fuse)*time.Second/20)
+	n.SetVelocity(nbtconv.MapVec3(data, "Motion"))
+	return n
+}
+
+// EncodeNBT encodes the TNT entity's properties as a map and returns it.
+func (t *TNT) EncodeNBT() map[string]interface{} {
+	return map[string]interface{}{
+		"Fuse":   uint8(atomic.LoadInt64(&t.fuse)),
+		"Pos":    nbtconv.Vec3ToFloat32Slice(t.Position()),
+		"Motion": nbtconv.Vec3ToFloat32Slice(t.Velocity()),
+	}
+}
+
+// Close closes the TNT, removing it from the world that it is currently in.
+func (t *TNT) Close() error {
+	if w := t.World(); w != nil {
+		w.RemoveEntity(t)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package entity
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+)
+
+// Zombie is a MobType of a common undead hostile mob. Zombies chase and attack players in survival mode that
+// come near them.
+type Zombie struct{}
+
+// EncodeEntity ...
+func (Zombie) EncodeEntity() string {
+	return "minecraft:zombie"
+}
+
+// AABB ...
+func (Zombie) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.3, 0, -0.3}, mgl64.Vec3{0.3, 1.9, 0.3})
+}
+
+// EyeHeight ...
+func (Zombie) EyeHeight() float64 {
+	return 1.74
+}
+
+// MaxHealth ...
+func (Zombie) MaxHealth() float64 {
+	return 20
+}
+
+// Speed ...
+func (Zombie) Speed() float64 {
+	return 0.23
+}
+
+// Drops ...
+func (Zombie) Drops() []item.Stack {
+	if n := rand.Intn(3); n > 0 {
+		return []item.Stack{item.NewStack(item.RottenFlesh{}, n)}
+	}
+	return nil
+}
+
+// Hostile ...
+func (Zombie) Hostile() bool {
+	return true
+}
+
+// Goals ...
+func (Zombie) Goals() []Goal {
+	return []Goal{
+		&AttackMeleeGoal{Speed: 1, Damage: 3},
+		&WanderGoal{Speed: 1},
+		&LookAtPlayerGoal{Distance: 8},
+		&TargetPlayerGoal{Distance: 16},
+	}
+}
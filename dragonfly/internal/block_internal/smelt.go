@@ -0,0 +1,12 @@
+package block_internal
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+)
+
+// Smelt is a function used to look up the output of smelting an item in the block with the name passed, such
+// as 'furnace', and the experience rewarded for it. Its value is set by the recipe package, which holds all
+// furnace recipes.
+var Smelt = func(input item.Stack, block string) (output item.Stack, experience float64, ok bool) {
+	return item.Stack{}, 0, false
+}
@@ -27,3 +27,10 @@ var IsWater func(b world.Liquid) bool
 
 // Replaceable is a function used to check if a block is replaceable.
 var Replaceable func(w *world.World, pos world.BlockPos, with world.Block) bool
+
+// Fire is a fire block, placed when using flint and steel.
+var Fire world.Block
+
+// Till is a function used to convert a block into the block it turns into when tilled using a hoe. If the
+// block cannot be tilled, false is returned.
+var Till func(b world.Block) (world.Block, bool)
@@ -0,0 +1,31 @@
+package nbtconv
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+//go:linkname world_blockByNameAndProperties github.com/df-mc/dragonfly/dragonfly/world.blockByNameAndProperties
+//noinspection ALL
+func world_blockByNameAndProperties(name string, properties map[string]interface{}) (world.Block, bool)
+
+// MapBlock reads a block stored as a name and states from a map at the key passed. If no such block exists at
+// the key, nil is returned.
+func MapBlock(m map[string]interface{}, key string) world.Block {
+	data, _ := m[key].(map[string]interface{})
+	properties, ok := data["states"].(map[string]interface{})
+	if !ok {
+		properties = map[string]interface{}{}
+	}
+	b, ok := world_blockByNameAndProperties(readString(data, "name"), properties)
+	if !ok {
+		return nil
+	}
+	return b
+}
+
+// BlockToNBT encodes a block as a map holding its name and states.
+func BlockToNBT(b world.Block) map[string]interface{} {
+	name, properties := b.EncodeBlock()
+	return map[string]interface{}{"name": name, "states": properties}
+}
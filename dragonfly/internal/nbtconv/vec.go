@@ -0,0 +1,28 @@
+package nbtconv
+
+import "github.com/go-gl/mathgl/mgl64"
+
+// MapVec3 reads a list of three floats from a map at the key passed and converts it to an mgl64.Vec3. If no
+// such list exists at the key, an empty mgl64.Vec3 is returned.
+func MapVec3(m map[string]interface{}, key string) mgl64.Vec3 {
+	l, _ := m[key].([]interface{})
+	if len(l) != 3 {
+		return mgl64.Vec3{}
+	}
+	var v mgl64.Vec3
+	for i, f := range l {
+		switch f := f.(type) {
+		case float32:
+			v[i] = float64(f)
+		case float64:
+			v[i] = f
+		}
+	}
+	return v
+}
+
+// Vec3ToFloat32Slice converts an mgl64.Vec3 to a slice of three float32s, so that it may be encoded as an
+// NBT list of floats.
+func Vec3ToFloat32Slice(v mgl64.Vec3) []float32 {
+	return []float32{float32(v[0]), float32(v[1]), float32(v[2])}
+}
@@ -0,0 +1,9 @@
+package item
+
+// Apple is a food item that drops from oak and dark oak leaves.
+type Apple struct{}
+
+// EncodeItem ...
+func (Apple) EncodeItem() (id int32, meta int16) {
+	return 260, 0
+}
@@ -0,0 +1,9 @@
+package item
+
+// Arrow is an item used as ammunition for bows.
+type Arrow struct{}
+
+// EncodeItem ...
+func (Arrow) EncodeItem() (id int32, meta int16) {
+	return 262, 0
+}
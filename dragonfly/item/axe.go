@@ -28,6 +28,11 @@ func (a Axe) UseOnBlock(pos world.BlockPos, _ world.Face, _ mgl64.Vec3, w *world
 	return false
 }
 
+// FuelInfo ...
+func (a Axe) FuelInfo() FuelInfo {
+	return toolFuel(a.Tier)
+}
+
 // MaxCount always returns 1.
 func (a Axe) MaxCount() int {
 	return 1
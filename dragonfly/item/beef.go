@@ -0,0 +1,15 @@
+package item
+
+// Beef is a food item dropped by cows. It may be cooked in a furnace to obtain steak.
+type Beef struct {
+	// Cooked specifies if the beef is cooked.
+	Cooked bool
+}
+
+// EncodeItem ...
+func (b Beef) EncodeItem() (id int32, meta int16) {
+	if b.Cooked {
+		return 364, 0
+	}
+	return 363, 0
+}
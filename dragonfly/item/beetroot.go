@@ -0,0 +1,9 @@
+package item
+
+// Beetroot is a food and dye ingredient obtained from harvesting fully grown beetroot crops.
+type Beetroot struct{}
+
+// EncodeItem ...
+func (Beetroot) EncodeItem() (id int32, meta int16) {
+	return 457, 0
+}
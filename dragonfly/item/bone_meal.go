@@ -0,0 +1,32 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/particle"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// BoneMeal is an item used to force growth in plants & crops.
+type BoneMeal struct{}
+
+// BoneMealAffected represents a block that is affected when bone meal is used on it, such as a crop.
+type BoneMealAffected interface {
+	// BoneMeal attempts to affect the block at the position passed using bone meal. It returns true if the
+	// block was affected, in which case the bone meal is consumed.
+	BoneMeal(pos world.BlockPos, w *world.World) bool
+}
+
+// UseOnBlock forces the growth of the block clicked if it is affected by bone meal.
+func (b BoneMeal) UseOnBlock(pos world.BlockPos, _ world.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	if affected, ok := w.Block(pos).(BoneMealAffected); ok && affected.BoneMeal(pos, w) {
+		w.AddParticle(pos.Vec3(), particle.BoneMeal{})
+		ctx.SubtractFromCount(1)
+		return true
+	}
+	return false
+}
+
+// EncodeItem ...
+func (b BoneMeal) EncodeItem() (id int32, meta int16) {
+	return 351, 15
+}
@@ -0,0 +1,100 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"math"
+	"time"
+)
+
+// Bow is a ranged weapon that shoots arrows. The longer a bow is drawn, the faster the arrow shot flies and
+// the more damage it deals.
+type Bow struct{}
+
+// MaxCount always returns 1.
+func (Bow) MaxCount() int {
+	return 1
+}
+
+// DurabilityInfo ...
+func (Bow) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability:    385,
+		BrokenItem:       simpleItem(Stack{}),
+		AttackDurability: 1,
+		BreakDurability:  1,
+	}
+}
+
+// Requirements returns an arrow stack: A bow may only be drawn by users that carry arrows, unless they are in
+// creative mode.
+func (Bow) Requirements() []Stack {
+	return []Stack{NewStack(Arrow{}, 1)}
+}
+
+// Release shoots an arrow with a force depending on the duration passed that the bow was drawn for. An arrow
+// is consumed from the inventory of the releaser, unless the bow has the Infinity enchantment or the releaser
+// is in creative mode.
+func (Bow) Release(releaser Releaser, duration time.Duration, ctx *UseContext) {
+	owner, ok := releaser.(world.Entity)
+	if !ok {
+		return
+	}
+	// The force is calculated the same way as in vanilla, ranging from 0.1 after drawing the bow for a few
+	// ticks to 1 after drawing it for a second.
+	t := duration.Seconds()
+	force := math.Min((t*t+t*2)/3, 1)
+	if force < 0.1 {
+		return
+	}
+	creative := releaser.GameMode() == gamemode.Creative{}
+	arrow, ok := ctx.First(func(s Stack) bool {
+		_, ok := s.Item().(Arrow)
+		return ok
+	})
+	if !ok && !creative {
+		return
+	}
+	held, _ := releaser.HeldItems()
+
+	dmg := 2.0
+	if power := enchantmentLevel(held, powerID); power > 0 {
+		dmg += float64(power)*0.5 + 0.5
+	}
+	infinity := enchantmentLevel(held, infinityID) > 0
+	pickup := !creative && !infinity
+
+	w := releaser.World()
+	w.AddEntity(entity_newArrow(owner, force*3, dmg, force >= 1, enchantmentLevel(held, punchID), enchantmentLevel(held, flameID) > 0, pickup))
+	w.PlaySound(releaser.Position(), sound.BowShoot{})
+
+	ctx.DamageItem(1)
+	if pickup {
+		ctx.Consume(arrow.Grow(1 - arrow.Count()))
+	}
+}
+
+// EncodeItem ...
+func (Bow) EncodeItem() (id int32, meta int16) {
+	return 261, 0
+}
+
+const (
+	// powerID, punchID, flameID and infinityID are the IDs of the bow enchantments, which are registered in
+	// the enchantment package.
+	powerID, punchID, flameID, infinityID = 19, 20, 21, 22
+)
+
+// enchantmentLevel returns the level of the enchantment registered with the ID passed on the stack passed. If
+// the stack does not have the enchantment, 0 is returned.
+func enchantmentLevel(s Stack, id int) int {
+	e, ok := enchantmentByID(id)
+	if !ok {
+		return 0
+	}
+	if e, ok := s.Enchantment(e); ok {
+		return e.Level()
+	}
+	return 0
+}
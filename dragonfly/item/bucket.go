@@ -6,6 +6,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/df-mc/dragonfly/dragonfly/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
+	"time"
 )
 
 // Bucket is a tool used to carry water, lava, milk and fish.
@@ -27,6 +28,15 @@ func (b Bucket) Empty() bool {
 	return b.Content == bucket.Content{}
 }
 
+// FuelInfo returns the fuel info of the bucket. Only lava buckets may be used as fuel, leaving behind an
+// empty bucket.
+func (b Bucket) FuelInfo() FuelInfo {
+	if b.Content == bucket.Lava() {
+		return FuelInfo{Duration: time.Second * 1000, Residue: NewStack(Bucket{}, 1)}
+	}
+	return FuelInfo{}
+}
+
 // UseOnBlock handles the bucket filling and emptying logic.
 func (b Bucket) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
 	if b.Empty() {
@@ -0,0 +1,15 @@
+package item
+
+// Chicken is a food item dropped by chickens. It may be cooked in a furnace to obtain cooked chicken.
+type Chicken struct {
+	// Cooked specifies if the chicken is cooked.
+	Cooked bool
+}
+
+// EncodeItem ...
+func (c Chicken) EncodeItem() (id int32, meta int16) {
+	if c.Cooked {
+		return 366, 0
+	}
+	return 365, 0
+}
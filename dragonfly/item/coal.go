@@ -0,0 +1,23 @@
+package item
+
+import "time"
+
+// Coal is an item used as fuel and for crafting torches. Charcoal is a variant of coal obtained by smelting
+// logs or wood.
+type Coal struct {
+	// Charcoal specifies if the coal is charcoal rather than regular coal.
+	Charcoal bool
+}
+
+// EncodeItem ...
+func (c Coal) EncodeItem() (id int32, meta int16) {
+	if c.Charcoal {
+		return 263, 1
+	}
+	return 263, 0
+}
+
+// FuelInfo ...
+func (Coal) FuelInfo() FuelInfo {
+	return FuelInfo{Duration: time.Second * 80}
+}
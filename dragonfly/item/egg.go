@@ -0,0 +1,32 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+)
+
+// Egg is a throwable item that, when thrown, has a chance to spawn chickens where it lands.
+type Egg struct{}
+
+// MaxCount ...
+func (Egg) MaxCount() int {
+	return 16
+}
+
+// Use ...
+func (Egg) Use(w *world.World, user User, ctx *UseContext) bool {
+	owner, ok := user.(world.Entity)
+	if !ok {
+		return false
+	}
+	w.AddEntity(entity_newEgg(owner))
+	w.PlaySound(user.Position(), sound.ItemThrow{})
+
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (Egg) EncodeItem() (id int32, meta int16) {
+	return 344, 0
+}
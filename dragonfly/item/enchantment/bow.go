@@ -0,0 +1,109 @@
+package enchantment
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+)
+
+// Flame is a bow enchantment that sets the arrows shot on fire, setting entities hit on fire.
+type Flame struct {
+	enchantment
+}
+
+// Name ...
+func (e Flame) Name() string {
+	return "Flame"
+}
+
+// MaxLevel ...
+func (e Flame) MaxLevel() int {
+	return 1
+}
+
+// WithLevel ...
+func (e Flame) WithLevel(level int) item.Enchantment {
+	return Flame{e.withLevel(level, e)}
+}
+
+// CompatibleWith ...
+func (e Flame) CompatibleWith(s item.Stack) bool {
+	_, ok := s.Item().(item.Bow)
+	return ok
+}
+
+// Infinity is a bow enchantment that prevents arrows from being consumed when shooting them.
+type Infinity struct {
+	enchantment
+}
+
+// Name ...
+func (e Infinity) Name() string {
+	return "Infinity"
+}
+
+// MaxLevel ...
+func (e Infinity) MaxLevel() int {
+	return 1
+}
+
+// WithLevel ...
+func (e Infinity) WithLevel(level int) item.Enchantment {
+	return Infinity{e.withLevel(level, e)}
+}
+
+// CompatibleWith ...
+func (e Infinity) CompatibleWith(s item.Stack) bool {
+	_, ok := s.Item().(item.Bow)
+	return ok
+}
+
+// Power is a bow enchantment that increases the damage dealt by arrows shot.
+type Power struct {
+	enchantment
+}
+
+// Name ...
+func (e Power) Name() string {
+	return "Power"
+}
+
+// MaxLevel ...
+func (e Power) MaxLevel() int {
+	return 5
+}
+
+// WithLevel ...
+func (e Power) WithLevel(level int) item.Enchantment {
+	return Power{e.withLevel(level, e)}
+}
+
+// CompatibleWith ...
+func (e Power) CompatibleWith(s item.Stack) bool {
+	_, ok := s.Item().(item.Bow)
+	return ok
+}
+
+// Punch is a bow enchantment that increases the knock-back of arrows shot.
+type Punch struct {
+	enchantment
+}
+
+// Name ...
+func (e Punch) Name() string {
+	return "Punch"
+}
+
+// MaxLevel ...
+func (e Punch) MaxLevel() int {
+	return 2
+}
+
+// WithLevel ...
+func (e Punch) WithLevel(level int) item.Enchantment {
+	return Punch{e.withLevel(level, e)}
+}
+
+// CompatibleWith ...
+func (e Punch) CompatibleWith(s item.Stack) bool {
+	_, ok := s.Item().(item.Bow)
+	return ok
+}
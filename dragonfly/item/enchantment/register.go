@@ -7,4 +7,8 @@ func init() {
 	item.RegisterEnchantment(1, FireProtection{})
 	item.RegisterEnchantment(3, BlastProtection{})
 	item.RegisterEnchantment(4, ProjectileProtection{})
+	item.RegisterEnchantment(19, Power{})
+	item.RegisterEnchantment(20, Punch{})
+	item.RegisterEnchantment(21, Flame{})
+	item.RegisterEnchantment(22, Infinity{})
 }
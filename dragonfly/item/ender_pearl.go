@@ -0,0 +1,32 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+)
+
+// EnderPearl is a throwable item that teleports the entity that threw it to the position where it lands.
+type EnderPearl struct{}
+
+// MaxCount ...
+func (EnderPearl) MaxCount() int {
+	return 16
+}
+
+// Use ...
+func (EnderPearl) Use(w *world.World, user User, ctx *UseContext) bool {
+	owner, ok := user.(world.Entity)
+	if !ok {
+		return false
+	}
+	w.AddEntity(entity_newEnderPearl(owner))
+	w.PlaySound(user.Position(), sound.ItemThrow{})
+
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (EnderPearl) EncodeItem() (id int32, meta int16) {
+	return 368, 0
+}
@@ -0,0 +1,9 @@
+package item
+
+// Feather is an item dropped by chickens, used to craft arrows.
+type Feather struct{}
+
+// EncodeItem ...
+func (Feather) EncodeItem() (id int32, meta int16) {
+	return 288, 0
+}
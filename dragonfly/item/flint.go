@@ -0,0 +1,9 @@
+package item
+
+// Flint is an item obtained from breaking gravel. It is used to craft flint and steel and arrows.
+type Flint struct{}
+
+// EncodeItem ...
+func (Flint) EncodeItem() (id int32, meta int16) {
+	return 318, 0
+}
@@ -0,0 +1,55 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/internal/item_internal"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// FlintAndSteel is an item used to light blocks on fire and to ignite blocks such as TNT.
+type FlintAndSteel struct{}
+
+// ignitable represents a block that may be ignited using flint and steel, such as TNT.
+type ignitable interface {
+	// Ignite ignites the block at the position passed. True is returned if the block was ignited.
+	Ignite(pos world.BlockPos, w *world.World) bool
+}
+
+// UseOnBlock ignites the block clicked if it is ignitable, or sets fire to the side of the block clicked.
+func (f FlintAndSteel) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	if i, ok := w.Block(pos).(ignitable); ok {
+		if !i.Ignite(pos, w) {
+			return false
+		}
+		w.PlaySound(pos.Vec3Centre(), sound.Ignite{})
+		ctx.DamageItem(1)
+		return true
+	}
+	s := pos.Side(face)
+	if !item_internal.Replaceable(w, s, item_internal.Fire) {
+		return false
+	}
+	w.PlaceBlock(s, item_internal.Fire)
+	w.PlaySound(s.Vec3Centre(), sound.Ignite{})
+	ctx.DamageItem(1)
+	return true
+}
+
+// MaxCount always returns 1.
+func (f FlintAndSteel) MaxCount() int {
+	return 1
+}
+
+// DurabilityInfo ...
+func (f FlintAndSteel) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability: 65,
+		BrokenItem:    simpleItem(Stack{}),
+	}
+}
+
+// EncodeItem ...
+func (f FlintAndSteel) EncodeItem() (id int32, meta int16) {
+	return 259, 0
+}
@@ -0,0 +1,31 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"time"
+)
+
+// Fuel represents an item that may be burned as fuel in a furnace, blast furnace or smoker.
+type Fuel interface {
+	// FuelInfo returns info related to the item when it is used as fuel.
+	FuelInfo() FuelInfo
+}
+
+// FuelInfo is the info of an item that may be used as fuel. It holds the duration that the item burns for
+// and the item left behind once it is consumed.
+type FuelInfo struct {
+	// Duration is the duration that the item burns for in a furnace. Blast furnaces and smokers burn fuel
+	// twice as fast. Items with a Duration of 0 cannot be used as fuel.
+	Duration time.Duration
+	// Residue is the item left behind in the fuel slot after the fuel is consumed, such as an empty bucket
+	// after burning a lava bucket. For most fuels, this is simply an empty stack.
+	Residue Stack
+}
+
+// toolFuel returns the fuel info of a tool with the tier passed. Only wooden tools may be used as fuel.
+func toolFuel(t tool.Tier) FuelInfo {
+	if t == tool.TierWood {
+		return FuelInfo{Duration: time.Second * 10}
+	}
+	return FuelInfo{}
+}
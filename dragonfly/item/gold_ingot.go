@@ -0,0 +1,9 @@
+package item
+
+// GoldIngot is a metal item obtained by smelting gold ore.
+type GoldIngot struct{}
+
+// EncodeItem ...
+func (GoldIngot) EncodeItem() (id int32, meta int16) {
+	return 266, 0
+}
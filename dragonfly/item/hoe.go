@@ -0,0 +1,95 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/internal/item_internal"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Hoe is a tool generally used to till dirt and grass blocks into farmland for farming. Additionally, a hoe
+// can be used to break certain plant-like blocks, such as leaves.
+type Hoe struct {
+	// Tier is the tier of the hoe.
+	Tier tool.Tier
+}
+
+// UseOnBlock handles the tilling of dirt and grass blocks into farmland.
+func (h Hoe) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w *world.World, _ User, ctx *UseContext) bool {
+	if face == world.FaceDown {
+		// Blocks are not tilled when the bottom face is clicked.
+		return false
+	}
+	if w.Block(pos.Side(world.FaceUp)) != item_internal.Air {
+		// Blocks can only be tilled if air is above them.
+		return false
+	}
+	if tilled, ok := item_internal.Till(w.Block(pos)); ok {
+		w.SetBlock(pos, tilled)
+		w.PlaySound(pos.Vec3(), sound.ItemUseOn{Block: tilled})
+
+		ctx.DamageItem(1)
+		return true
+	}
+	return false
+}
+
+// FuelInfo ...
+func (h Hoe) FuelInfo() FuelInfo {
+	return toolFuel(h.Tier)
+}
+
+// MaxCount always returns 1.
+func (h Hoe) MaxCount() int {
+	return 1
+}
+
+// AttackDamage returns the attack damage of the hoe.
+func (h Hoe) AttackDamage() float64 {
+	return h.Tier.BaseAttackDamage
+}
+
+// ToolType returns the tool type for hoes.
+func (h Hoe) ToolType() tool.Type {
+	return tool.TypeHoe
+}
+
+// HarvestLevel ...
+func (h Hoe) HarvestLevel() int {
+	return h.Tier.HarvestLevel
+}
+
+// BaseMiningEfficiency ...
+func (h Hoe) BaseMiningEfficiency(world.Block) float64 {
+	return h.Tier.BaseMiningEfficiency
+}
+
+// DurabilityInfo ...
+func (h Hoe) DurabilityInfo() DurabilityInfo {
+	return DurabilityInfo{
+		MaxDurability:    h.Tier.Durability,
+		BrokenItem:       simpleItem(Stack{}),
+		AttackDurability: 1,
+		BreakDurability:  1,
+	}
+}
+
+// EncodeItem ...
+func (h Hoe) EncodeItem() (id int32, meta int16) {
+	switch h.Tier {
+	case tool.TierWood:
+		return 290, 0
+	case tool.TierGold:
+		return 294, 0
+	case tool.TierStone:
+		return 291, 0
+	case tool.TierIron:
+		return 292, 0
+	case tool.TierDiamond:
+		return 293, 0
+	case tool.TierNetherite:
+		return 747, 0
+	}
+	panic("invalid hoe tier")
+}
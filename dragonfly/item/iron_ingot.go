@@ -0,0 +1,9 @@
+package item
+
+// IronIngot is a metal item obtained by smelting iron ore.
+type IronIngot struct{}
+
+// EncodeItem ...
+func (IronIngot) EncodeItem() (id int32, meta int16) {
+	return 265, 0
+}
@@ -2,7 +2,9 @@ package item
 
 import (
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
 	"github.com/go-gl/mathgl/mgl64"
+	"time"
 )
 
 // MaxCounter represents an item that has a specific max count. By default, each item will be expected to have
@@ -42,6 +44,27 @@ type Usable interface {
 	Use(w *world.World, user User, ctx *UseContext) bool
 }
 
+// Releasable represents an item that may be used for a duration before being released, such as a bow. When
+// an item implementing this interface is used in the air, the user starts using it. Release is called once the
+// user stops using the item.
+type Releasable interface {
+	// Requirements returns the items of which the user must carry at least one in order to start using the
+	// item, such as arrows for a bow. If an empty slice is returned, the item may always be used.
+	Requirements() []Stack
+	// Release is called when the user releases the item after having used it for the duration passed.
+	Release(releaser Releaser, duration time.Duration, ctx *UseContext)
+}
+
+// Releaser represents an entity that is able to use and release a Releasable item, such as a player drawing
+// a bow.
+type Releaser interface {
+	User
+	// World returns the world that the releaser is currently in.
+	World() *world.World
+	// GameMode returns the game mode of the releaser.
+	GameMode() gamemode.GameMode
+}
+
 // UseContext is passed to every item Use methods. It may be used to subtract items or to deal damage to them
 // after the action is complete.
 type UseContext struct {
@@ -50,6 +73,12 @@ type UseContext struct {
 	// NewItem is the item that is added after the item is used. If the player no longer has an item in the
 	// hand, it'll be added there.
 	NewItem Stack
+	// ConsumedItems holds items other than the item used that are removed from the inventory of the user
+	// after the item is used, such as the arrows shot by a bow.
+	ConsumedItems []Stack
+	// FirstFunc returns the first item in the inventory of the user for which the comparable function passed
+	// returns true. It is set by the user of the item and may be nil.
+	FirstFunc func(comparable func(Stack) bool) (Stack, bool)
 }
 
 // DamageItem damages the item used by d points.
@@ -58,6 +87,18 @@ func (ctx *UseContext) DamageItem(d int) { ctx.Damage += d }
 // SubtractFromCount subtracts d from the count of the item stack used.
 func (ctx *UseContext) SubtractFromCount(d int) { ctx.CountSub += d }
 
+// Consume consumes the stack passed from the inventory of the user.
+func (ctx *UseContext) Consume(s Stack) { ctx.ConsumedItems = append(ctx.ConsumedItems, s) }
+
+// First returns the first item in the inventory of the user for which the comparable function passed returns
+// true. If no such item could be found, false is returned.
+func (ctx *UseContext) First(comparable func(Stack) bool) (Stack, bool) {
+	if ctx.FirstFunc == nil {
+		return Stack{}, false
+	}
+	return ctx.FirstFunc(comparable)
+}
+
 // Weapon is an item that may be used as a weapon. It has an attack damage which may be different to the 2
 // damage that attacking with an empty hand deals.
 type Weapon interface {
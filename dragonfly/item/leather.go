@@ -0,0 +1,9 @@
+package item
+
+// Leather is an animal skin dropped by cows, used to craft armour and books.
+type Leather struct{}
+
+// EncodeItem ...
+func (Leather) EncodeItem() (id int32, meta int16) {
+	return 334, 0
+}
@@ -0,0 +1,9 @@
+package item
+
+// MelonSlice is a food item dropped by melon blocks.
+type MelonSlice struct{}
+
+// EncodeItem ...
+func (MelonSlice) EncodeItem() (id int32, meta int16) {
+	return 360, 0
+}
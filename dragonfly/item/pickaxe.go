@@ -29,6 +29,11 @@ func (p Pickaxe) BaseMiningEfficiency(world.Block) float64 {
 	return p.Tier.BaseMiningEfficiency
 }
 
+// FuelInfo ...
+func (p Pickaxe) FuelInfo() FuelInfo {
+	return toolFuel(p.Tier)
+}
+
 // MaxCount returns 1.
 func (p Pickaxe) MaxCount() int {
 	return 1
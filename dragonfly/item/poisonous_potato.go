@@ -0,0 +1,9 @@
+package item
+
+// PoisonousPotato is a food item that has a small chance to drop from harvesting fully grown potato crops.
+type PoisonousPotato struct{}
+
+// EncodeItem ...
+func (PoisonousPotato) EncodeItem() (id int32, meta int16) {
+	return 394, 0
+}
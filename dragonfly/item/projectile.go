@@ -0,0 +1,25 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// The following functions use the go:linkname directive in order to launch projectile entities without the
+// item package having to import the entity package.
+
+//go:linkname entity_newSnowball github.com/df-mc/dragonfly/dragonfly/entity.newSnowball
+//noinspection ALL
+func entity_newSnowball(owner world.Entity) world.Entity
+
+//go:linkname entity_newEgg github.com/df-mc/dragonfly/dragonfly/entity.newEgg
+//noinspection ALL
+func entity_newEgg(owner world.Entity) world.Entity
+
+//go:linkname entity_newEnderPearl github.com/df-mc/dragonfly/dragonfly/entity.newEnderPearl
+//noinspection ALL
+func entity_newEnderPearl(owner world.Entity) world.Entity
+
+//go:linkname entity_newArrow github.com/df-mc/dragonfly/dragonfly/entity.newArrow
+//noinspection ALL
+func entity_newArrow(owner world.Entity, force, damage float64, critical bool, punch int, flame, pickup bool) world.Entity
@@ -30,6 +30,13 @@ func init() {
 	world.RegisterItem("minecraft:diamond_shovel", Shovel{Tier: tool.TierDiamond})
 	world.RegisterItem("minecraft:netherite_shovel", Shovel{Tier: tool.TierNetherite})
 
+	world.RegisterItem("minecraft:wooden_hoe", Hoe{Tier: tool.TierWood})
+	world.RegisterItem("minecraft:golden_hoe", Hoe{Tier: tool.TierGold})
+	world.RegisterItem("minecraft:stone_hoe", Hoe{Tier: tool.TierStone})
+	world.RegisterItem("minecraft:iron_hoe", Hoe{Tier: tool.TierIron})
+	world.RegisterItem("minecraft:diamond_hoe", Hoe{Tier: tool.TierDiamond})
+	world.RegisterItem("minecraft:netherite_hoe", Hoe{Tier: tool.TierNetherite})
+
 	world.RegisterItem("minecraft:wooden_sword", Sword{Tier: tool.TierWood})
 	world.RegisterItem("minecraft:golden_sword", Sword{Tier: tool.TierGold})
 	world.RegisterItem("minecraft:stone_sword", Sword{Tier: tool.TierStone})
@@ -68,4 +75,32 @@ func init() {
 	world.RegisterItem("minecraft:bucket", Bucket{})
 	world.RegisterItem("minecraft:bucket", Bucket{Content: bucket.Water()})
 	world.RegisterItem("minecraft:bucket", Bucket{Content: bucket.Lava()})
+
+	world.RegisterItem("minecraft:stick", Stick{})
+	world.RegisterItem("minecraft:coal", Coal{})
+	world.RegisterItem("minecraft:coal", Coal{Charcoal: true})
+	world.RegisterItem("minecraft:diamond", Diamond{})
+	world.RegisterItem("minecraft:iron_ingot", IronIngot{})
+	world.RegisterItem("minecraft:gold_ingot", GoldIngot{})
+	world.RegisterItem("minecraft:emerald", Emerald{})
+	world.RegisterItem("minecraft:leather", Leather{})
+	world.RegisterItem("minecraft:feather", Feather{})
+	world.RegisterItem("minecraft:rotten_flesh", RottenFlesh{})
+	world.RegisterItem("minecraft:wheat", Wheat{})
+	world.RegisterItem("minecraft:beef", Beef{})
+	world.RegisterItem("minecraft:cooked_beef", Beef{Cooked: true})
+	world.RegisterItem("minecraft:chicken", Chicken{})
+	world.RegisterItem("minecraft:cooked_chicken", Chicken{Cooked: true})
+	world.RegisterItem("minecraft:snowball", Snowball{})
+	world.RegisterItem("minecraft:egg", Egg{})
+	world.RegisterItem("minecraft:ender_pearl", EnderPearl{})
+	world.RegisterItem("minecraft:arrow", Arrow{})
+	world.RegisterItem("minecraft:bow", Bow{})
+	world.RegisterItem("minecraft:flint_and_steel", FlintAndSteel{})
+	world.RegisterItem("minecraft:flint", Flint{})
+	world.RegisterItem("minecraft:dye", BoneMeal{})
+	world.RegisterItem("minecraft:apple", Apple{})
+	world.RegisterItem("minecraft:beetroot", Beetroot{})
+	world.RegisterItem("minecraft:melon", MelonSlice{})
+	world.RegisterItem("minecraft:poisonous_potato", PoisonousPotato{})
 }
@@ -0,0 +1,9 @@
+package item
+
+// RottenFlesh is an item dropped by zombies.
+type RottenFlesh struct{}
+
+// EncodeItem ...
+func (RottenFlesh) EncodeItem() (id int32, meta int16) {
+	return 367, 0
+}
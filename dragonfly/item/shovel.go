@@ -35,6 +35,11 @@ func (s Shovel) UseOnBlock(pos world.BlockPos, face world.Face, _ mgl64.Vec3, w
 	return false
 }
 
+// FuelInfo ...
+func (s Shovel) FuelInfo() FuelInfo {
+	return toolFuel(s.Tier)
+}
+
 // MaxCount always returns 1.
 func (s Shovel) MaxCount() int {
 	return 1
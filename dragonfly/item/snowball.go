@@ -0,0 +1,32 @@
+package item
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/sound"
+)
+
+// Snowball is a throwable item that may be thrown at entities to knock them back.
+type Snowball struct{}
+
+// MaxCount ...
+func (Snowball) MaxCount() int {
+	return 16
+}
+
+// Use ...
+func (Snowball) Use(w *world.World, user User, ctx *UseContext) bool {
+	owner, ok := user.(world.Entity)
+	if !ok {
+		return false
+	}
+	w.AddEntity(entity_newSnowball(owner))
+	w.PlaySound(user.Position(), sound.ItemThrow{})
+
+	ctx.SubtractFromCount(1)
+	return true
+}
+
+// EncodeItem ...
+func (Snowball) EncodeItem() (id int32, meta int16) {
+	return 332, 0
+}
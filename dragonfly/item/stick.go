@@ -0,0 +1,16 @@
+package item
+
+import "time"
+
+// Stick is one of the most abundant resources used for crafting many tools and items.
+type Stick struct{}
+
+// EncodeItem ...
+func (Stick) EncodeItem() (id int32, meta int16) {
+	return 280, 0
+}
+
+// FuelInfo ...
+func (Stick) FuelInfo() FuelInfo {
+	return FuelInfo{Duration: time.Second * 5}
+}
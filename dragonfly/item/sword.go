@@ -17,6 +17,11 @@ func (s Sword) AttackDamage() float64 {
 	return s.Tier.BaseAttackDamage + 3
 }
 
+// FuelInfo ...
+func (s Sword) FuelInfo() FuelInfo {
+	return toolFuel(s.Tier)
+}
+
 // MaxCount always returns 1.
 func (s Sword) MaxCount() int {
 	return 1
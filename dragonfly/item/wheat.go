@@ -0,0 +1,9 @@
+package item
+
+// Wheat is a crop used to breed and tempt cows, and to craft bread.
+type Wheat struct{}
+
+// EncodeItem ...
+func (Wheat) EncodeItem() (id int32, meta int16) {
+	return 296, 0
+}
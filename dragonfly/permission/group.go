@@ -0,0 +1,24 @@
+package permission
+
+// Group is a named group of permissions. Players may be added to groups to grant them the permissions of the
+// group.
+type Group struct {
+	// Name is the name of the group. It uniquely identifies the group.
+	Name string
+	// Inherits holds the names of the groups that the group inherits permissions from. Permissions of the
+	// group itself take precedence over the permissions inherited.
+	Inherits []string
+	// Permissions is the set of permission nodes granted or denied by the group.
+	Permissions Set
+}
+
+// Entry holds the permissions of a single player: The groups that it is in and the permission nodes that are
+// granted or denied to the player specifically.
+type Entry struct {
+	// Groups holds the names of the groups that the player is in. If empty, the player is in the
+	// DefaultGroup.
+	Groups []string
+	// Permissions holds the permission nodes granted or denied to the player specifically. They take
+	// precedence over the permissions of the groups that the player is in.
+	Permissions Set
+}
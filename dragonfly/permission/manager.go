@@ -0,0 +1,257 @@
+package permission
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Manager manages permission groups and the permissions of players. Changes made to a Manager are saved to
+// its Store immediately. A Manager is safe for concurrent use.
+type Manager struct {
+	store Store
+
+	mu      sync.RWMutex
+	groups  map[string]Group
+	players map[uuid.UUID]Entry
+	subs    []func()
+}
+
+// NewManager creates a Manager that loads its data from the Store passed and saves changes to it. If the
+// Store does not yet hold the DefaultGroup and OperatorGroup, they are created: The DefaultGroup grants no
+// permissions and the OperatorGroup grants all permissions.
+func NewManager(store Store) (*Manager, error) {
+	d, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+	m := &Manager{store: store, groups: d.Groups, players: d.Players}
+	if m.groups == nil {
+		m.groups = map[string]Group{}
+	}
+	if m.players == nil {
+		m.players = map[uuid.UUID]Entry{}
+	}
+	_, defaultOk := m.groups[DefaultGroup]
+	_, operatorOk := m.groups[OperatorGroup]
+	if defaultOk && operatorOk {
+		return m, nil
+	}
+	if !defaultOk {
+		m.groups[DefaultGroup] = Group{Name: DefaultGroup}
+	}
+	if !operatorOk {
+		m.groups[OperatorGroup] = Group{Name: OperatorGroup, Permissions: Set{"*"}}
+	}
+	if err := m.save(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Has checks if the player with the UUID passed has the permission node passed. Permissions granted or
+// denied to the player specifically are checked first, after which the groups of the player are checked. If
+// a group does not match the node, the groups it inherits from are checked. If the player is not in any
+// group, the permissions of the DefaultGroup are used.
+func (m *Manager) Has(id uuid.UUID, node string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	e := m.players[id]
+	if allowed, ok := e.Permissions.check(node); ok {
+		return allowed
+	}
+	groups := e.Groups
+	if len(groups) == 0 {
+		groups = []string{DefaultGroup}
+	}
+	visited := map[string]bool{}
+	for len(groups) != 0 {
+		// Groups are checked one level of inheritance at a time, so that the permissions of a group always
+		// take precedence over the ones it inherits. Within a level, a node granted by any group is enough.
+		var next []string
+		found, allowed := false, false
+		for _, name := range groups {
+			g, ok := m.groups[name]
+			if !ok || visited[name] {
+				continue
+			}
+			visited[name] = true
+			if groupAllowed, ok := g.Permissions.check(node); ok {
+				found, allowed = true, allowed || groupAllowed
+			}
+			next = append(next, g.Inherits...)
+		}
+		if found {
+			return allowed
+		}
+		groups = next
+	}
+	return false
+}
+
+// Group looks up a group by its name. If no group with the name exists, false is returned.
+func (m *Manager) Group(name string) (Group, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	g, ok := m.groups[name]
+	return g, ok
+}
+
+// Groups returns all groups of the Manager, sorted by their name.
+func (m *Manager) Groups() []Group {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	groups := make([]Group, 0, len(m.groups))
+	for _, g := range m.groups {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Name < groups[j].Name
+	})
+	return groups
+}
+
+// SetGroup adds the group passed to the Manager, replacing any group with the same name. The groups that the
+// group inherits from must exist.
+func (m *Manager) SetGroup(g Group) error {
+	if g.Name == "" {
+		return fmt.Errorf("group name must not be empty")
+	}
+	return m.update(func() error {
+		for _, name := range g.Inherits {
+			if _, ok := m.groups[name]; !ok {
+				return fmt.Errorf("inherited group %v does not exist", name)
+			}
+		}
+		m.groups[g.Name] = g
+		return nil
+	})
+}
+
+// RemoveGroup removes the group with the name passed from the Manager. Players in the group and groups that
+// inherit from it are updated accordingly. The DefaultGroup cannot be removed.
+func (m *Manager) RemoveGroup(name string) error {
+	if name == DefaultGroup {
+		return fmt.Errorf("default group cannot be removed")
+	}
+	return m.update(func() error {
+		if _, ok := m.groups[name]; !ok {
+			return fmt.Errorf("group %v does not exist", name)
+		}
+		delete(m.groups, name)
+		for n, g := range m.groups {
+			g.Inherits = removeString(g.Inherits, name)
+			m.groups[n] = g
+		}
+		for id, e := range m.players {
+			e.Groups = removeString(e.Groups, name)
+			m.players[id] = e
+		}
+		return nil
+	})
+}
+
+// Entry returns the permission entry of the player with the UUID passed. If the player has no entry, an
+// empty entry is returned.
+func (m *Manager) Entry(id uuid.UUID) Entry {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.players[id]
+}
+
+// AddToGroup adds the player with the UUID passed to the group with the name passed.
+func (m *Manager) AddToGroup(id uuid.UUID, group string) error {
+	return m.update(func() error {
+		if _, ok := m.groups[group]; !ok {
+			return fmt.Errorf("group %v does not exist", group)
+		}
+		e := m.players[id]
+		e.Groups = append(removeString(e.Groups, group), group)
+		m.players[id] = e
+		return nil
+	})
+}
+
+// RemoveFromGroup removes the player with the UUID passed from the group with the name passed.
+func (m *Manager) RemoveFromGroup(id uuid.UUID, group string) error {
+	return m.update(func() error {
+		e := m.players[id]
+		e.Groups = removeString(e.Groups, group)
+		m.players[id] = e
+		return nil
+	})
+}
+
+// SetPermission grants or denies the permission node passed to the player with the UUID passed,
+// regardless of the groups that the player is in.
+func (m *Manager) SetPermission(id uuid.UUID, node string, allowed bool) error {
+	node = strings.TrimPrefix(node, "-")
+	if !allowed {
+		node = "-" + node
+	}
+	return m.update(func() error {
+		e := m.players[id]
+		e.Permissions = e.Permissions.with(node)
+		m.players[id] = e
+		return nil
+	})
+}
+
+// UnsetPermission removes the permission node passed from the permissions granted or denied to the player
+// with the UUID passed specifically. The groups of the player decide whether it has the permission again.
+func (m *Manager) UnsetPermission(id uuid.UUID, node string) error {
+	return m.update(func() error {
+		e := m.players[id]
+		e.Permissions = e.Permissions.without(strings.TrimPrefix(node, "-"))
+		m.players[id] = e
+		return nil
+	})
+}
+
+// Subscribe subscribes the function passed to changes of the Manager. It is called every time a group or the
+// permissions of a player change.
+func (m *Manager) Subscribe(f func()) {
+	m.mu.Lock()
+	m.subs = append(m.subs, f)
+	m.mu.Unlock()
+}
+
+// update calls the function passed with the Manager locked and saves the data of the Manager if it returns
+// no error. Subscribers are notified of the change once the Manager is unlocked.
+func (m *Manager) update(f func() error) error {
+	m.mu.Lock()
+	if err := f(); err != nil {
+		m.mu.Unlock()
+		return err
+	}
+	err := m.save()
+	subs := append([]func(){}, m.subs...)
+	m.mu.Unlock()
+
+	for _, sub := range subs {
+		sub()
+	}
+	return err
+}
+
+// save saves the data of the Manager to its Store. The Manager must be locked when save is called.
+func (m *Manager) save() error {
+	if err := m.store.Save(Data{Groups: m.groups, Players: m.players}); err != nil {
+		return fmt.Errorf("error saving permissions: %w", err)
+	}
+	return nil
+}
+
+// removeString returns a copy of the slice passed without the string s.
+func removeString(slice []string, s string) []string {
+	n := make([]string, 0, len(slice))
+	for _, str := range slice {
+		if str != s {
+			n = append(n, str)
+		}
+	}
+	return n
+}
@@ -0,0 +1,93 @@
+// Package permission implements permission nodes, groups of permissions and the storage of both.
+//
+// Permission nodes are dot separated names, such as 'dragonfly.command.kick'. A node ending with '.*' is a
+// wildcard that matches all nodes starting with it, such as 'dragonfly.command.*', and the node '*' matches
+// every node. Nodes prefixed with a '-' deny the nodes they match rather than granting them.
+// Players may be added to groups, which hold a set of permissions and may inherit the permissions of other
+// groups. Permissions and groups are managed using a Manager, which stores them using a Store.
+package permission
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	// Operator is the permission node that gives a player operator status. Operators are shown as such in
+	// the player list and have access to the operator abilities of the client.
+	Operator = "dragonfly.operator"
+	// DefaultGroup is the name of the group that players that are not in any group are in.
+	DefaultGroup = "default"
+	// OperatorGroup is the name of the group created by default that grants all permissions.
+	OperatorGroup = "operator"
+)
+
+// Holder represents a value that holds permissions, such as a player.
+type Holder interface {
+	// HasPermission checks if the holder has the permission node passed.
+	HasPermission(node string) bool
+}
+
+// Set is a set of permission nodes. A Set may hold wildcard nodes and nodes prefixed with '-' to deny the
+// nodes that they match.
+type Set []string
+
+// Allows checks if the Set grants the node passed. If no node in the Set matches the node passed, false is
+// returned.
+func (s Set) Allows(node string) bool {
+	allowed, _ := s.check(node)
+	return allowed
+}
+
+// check checks if the Set grants the node passed. The bool ok is false if none of the nodes in the Set match
+// the node passed. If multiple nodes match, the most specific one decides: An exact match takes precedence
+// over a wildcard, and longer wildcards take precedence over shorter ones. If a granting and a denying node
+// are equally specific, the node is denied.
+func (s Set) check(node string) (allowed, ok bool) {
+	node = strings.ToLower(node)
+	best := -1
+	for _, n := range s {
+		deny := strings.HasPrefix(n, "-")
+		specificity := matches(strings.ToLower(strings.TrimPrefix(n, "-")), node)
+		if specificity < 0 || specificity < best {
+			continue
+		}
+		if specificity > best || deny {
+			allowed = !deny
+		}
+		best = specificity
+	}
+	return allowed, best >= 0
+}
+
+// with returns a copy of the Set with the node passed added to it, replacing any node that is the same
+// except for being granted or denied.
+func (s Set) with(node string) Set {
+	return append(s.without(strings.TrimPrefix(node, "-")), node)
+}
+
+// without returns a copy of the Set with the node passed removed from it, regardless of whether it is
+// granted or denied in the Set.
+func (s Set) without(node string) Set {
+	n := make(Set, 0, len(s))
+	for _, existing := range s {
+		if !strings.EqualFold(strings.TrimPrefix(existing, "-"), node) {
+			n = append(n, existing)
+		}
+	}
+	return n
+}
+
+// matches checks if the node pattern passed matches the node passed. If it does not, -1 is returned. If it
+// does, the specificity of the match is returned, which is higher for more specific patterns.
+func matches(pattern, node string) int {
+	switch {
+	case pattern == node:
+		return math.MaxInt32
+	case pattern == "*":
+		return 0
+	case strings.HasSuffix(pattern, ".*") && strings.HasPrefix(node, pattern[:len(pattern)-1]):
+		return len(pattern)
+	}
+	return -1
+}
@@ -0,0 +1,84 @@
+package permission
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/google/uuid"
+	"io/ioutil"
+	"os"
+)
+
+// Store represents a value that stores permission Data persistently, so that groups and the permissions of
+// players are kept between restarts.
+type Store interface {
+	// Load loads the Data held by the Store. If the Store does not hold any Data yet, empty Data is returned.
+	Load() (Data, error)
+	// Save saves the Data passed to the Store, overwriting any Data previously saved.
+	Save(d Data) error
+}
+
+// Data holds all groups and the permissions of all players held by a Manager.
+type Data struct {
+	// Groups holds all groups, indexed by their name.
+	Groups map[string]Group
+	// Players holds the permission entries of players, indexed by their UUID.
+	Players map[uuid.UUID]Entry
+}
+
+// NopStore implements a Store that does not store any data. Permissions of a Manager using it are lost when
+// the server stops.
+type NopStore struct{}
+
+// Compile time check to make sure NopStore implements Store.
+var _ Store = NopStore{}
+
+// Load ...
+func (NopStore) Load() (Data, error) {
+	return Data{}, nil
+}
+
+// Save ...
+func (NopStore) Save(Data) error {
+	return nil
+}
+
+// JSONStore implements a Store that stores permission data in a JSON file.
+type JSONStore struct {
+	file string
+}
+
+// Compile time check to make sure JSONStore implements Store.
+var _ Store = (*JSONStore)(nil)
+
+// NewJSONStore returns a JSONStore that reads and writes data in the file passed. The file is created when
+// data is first saved.
+func NewJSONStore(file string) *JSONStore {
+	return &JSONStore{file: file}
+}
+
+// Load loads the data from the file of the JSONStore. If the file does not exist, empty Data is returned.
+func (s *JSONStore) Load() (Data, error) {
+	b, err := ioutil.ReadFile(s.file)
+	if os.IsNotExist(err) {
+		return Data{}, nil
+	} else if err != nil {
+		return Data{}, fmt.Errorf("error reading permission file: %w", err)
+	}
+	var d Data
+	if err := json.Unmarshal(b, &d); err != nil {
+		return Data{}, fmt.Errorf("error decoding permission file: %w", err)
+	}
+	return d, nil
+}
+
+// Save saves the data passed to the file of the JSONStore.
+func (s *JSONStore) Save(d Data) error {
+	b, err := json.MarshalIndent(d, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error encoding permission data: %w", err)
+	}
+	if err := ioutil.WriteFile(s.file, b, 0644); err != nil {
+		return fmt.Errorf("error writing permission file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package player
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity"
+	"github.com/df-mc/dragonfly/dragonfly/item/inventory"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// Data holds the data of a Player that is persisted when the player leaves the server, so that it may be
+// restored when the player joins again. Data may be obtained using Player.Data and applied to a Player using
+// Player.LoadData.
+type Data struct {
+	// World is the name of the world that the player is in. It is empty if the player was not in a world.
+	World string
+	// Position is the position of the player in the world.
+	Position mgl64.Vec3
+	// Yaw and Pitch hold the rotation of the player.
+	Yaw, Pitch float64
+	// Health and MaxHealth hold the current and maximum health of the player. Absorption holds the absorption
+	// health that the player has.
+	Health, MaxHealth, Absorption float64
+	// Food is the food level of the player. Saturation and Exhaustion hold the saturation and exhaustion
+	// levels of the player.
+	Food                   int
+	Saturation, Exhaustion float64
+	// Experience is the total amount of experience points that the player has collected.
+	Experience int
+	// GameMode is the game mode of the player.
+	GameMode gamemode.GameMode
+	// Effects holds all lasting effects that the player has.
+	Effects []entity.Effect
+	// Inventory, OffHand and Armour hold copies of the inventories of the player.
+	Inventory, OffHand *inventory.Inventory
+	Armour             *inventory.Armour
+	// SpawnPoint is the position of the head of the bed that the player respawns at. It is nil if the player
+	// has no spawn point set.
+	SpawnPoint *world.BlockPos
+	// SpawnWorld is the name of the world that the spawn point of the player is in. It is empty if the player
+	// has no spawn point set.
+	SpawnWorld string
+}
+
+// Data returns the Data of the player, holding a snapshot of its current state. The inventories in the Data
+// returned are copies of those of the player, so changing them does not change the inventories of the player.
+func (p *Player) Data() Data {
+	p.hunger.mu.RLock()
+	food, saturation, exhaustion := p.hunger.foodLevel, p.hunger.saturationLevel, p.hunger.exhaustionLevel
+	p.hunger.mu.RUnlock()
+
+	var spawnPoint *world.BlockPos
+	var spawnWorld string
+	if p.spawnPointSet.Load() {
+		spawn := p.spawnPoint.Load().(bedSpawn)
+		spawnPoint, spawnWorld = &spawn.pos, spawn.w.Name()
+	}
+	var worldName string
+	if w := p.World(); w != nil {
+		worldName = w.Name()
+	}
+	return Data{
+		World:      worldName,
+		Position:   p.Position(),
+		Yaw:        p.Yaw(),
+		Pitch:      p.Pitch(),
+		Health:     p.Health(),
+		MaxHealth:  p.MaxHealth(),
+		Absorption: p.absorption(),
+		Food:       food,
+		Saturation: saturation,
+		Exhaustion: exhaustion,
+		Experience: p.Experience(),
+		GameMode:   p.GameMode(),
+		Effects:    p.Effects(),
+		Inventory:  copyInventory(p.inv, inventory.New(p.inv.Size(), nil)),
+		OffHand:    copyInventory(p.offHand, inventory.New(p.offHand.Size(), nil)),
+		Armour:     copyArmour(p.armour, inventory.NewArmour(nil)),
+		SpawnPoint: spawnPoint,
+		SpawnWorld: spawnWorld,
+	}
+}
+
+// LoadData applies the Data passed to the player, restoring the state it holds. The player is teleported
+// to the position in the Data, and its inventories are overwritten with the contents of those in the Data.
+// LoadData should be called after the player has been added to a world. The spawn point in the Data is only
+// restored if it is in the world that the player is in, as the player cannot look up other worlds by their
+// name. Spawn points in other worlds must be restored using Player.SetSpawnPoint.
+func (p *Player) LoadData(d Data) {
+	p.yaw.Store(d.Yaw)
+	p.pitch.Store(d.Pitch)
+	if p.World() != nil {
+		p.teleport(d.Position)
+	} else {
+		p.pos.Store(d.Position)
+	}
+
+	if d.MaxHealth > 0 {
+		p.SetMaxHealth(d.MaxHealth)
+	}
+	if d.Health > 0 {
+		p.addHealth(d.Health - p.Health())
+	}
+	p.SetAbsorption(d.Absorption)
+
+	p.hunger.mu.Lock()
+	p.hunger.foodLevel, p.hunger.saturationLevel, p.hunger.exhaustionLevel = d.Food, d.Saturation, d.Exhaustion
+	p.hunger.mu.Unlock()
+	p.sendFood()
+
+	p.experience.Store(int64(d.Experience))
+	p.sendExperience()
+
+	if d.GameMode != nil {
+		p.SetGameMode(d.GameMode)
+	}
+	for _, e := range d.Effects {
+		p.AddEffect(e)
+	}
+	if d.Inventory != nil {
+		copyInventory(d.Inventory, p.inv)
+	}
+	if d.OffHand != nil {
+		copyInventory(d.OffHand, p.offHand)
+	}
+	if d.Armour != nil {
+		copyArmour(d.Armour, p.armour)
+	}
+	if w := p.World(); w != nil && d.SpawnPoint != nil && (d.SpawnWorld == "" || d.SpawnWorld == w.Name()) {
+		p.SetSpawnPoint(w, *d.SpawnPoint)
+	}
+}
+
+// copyInventory copies all items from the inventory src into the inventory dst and returns dst.
+func copyInventory(src, dst *inventory.Inventory) *inventory.Inventory {
+	for slot, it := range src.All() {
+		if slot >= dst.Size() {
+			break
+		}
+		_ = dst.SetItem(slot, it)
+	}
+	return dst
+}
+
+// copyArmour copies all armour items from the armour inventory src into the armour inventory dst and returns
+// dst.
+func copyArmour(src, dst *inventory.Armour) *inventory.Armour {
+	copyInventory(src.Inv(), dst.Inv())
+	return dst
+}
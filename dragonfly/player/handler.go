@@ -6,9 +6,11 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/entity/healing"
 	"github.com/df-mc/dragonfly/dragonfly/event"
 	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/recipe"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"net"
+	"time"
 )
 
 // Handler handles events that are called by a player. Implementations of Handler may be used to listen to
@@ -48,10 +50,20 @@ type Handler interface {
 	// HandleBlockPlace handles the player placing a specific block at a position in its world. ctx.Cancel()
 	// may be called to cancel the block being placed.
 	HandleBlockPlace(ctx *event.Context, pos world.BlockPos, b world.Block)
+	// HandleSignEdit handles the player editing the text of the sign at the position passed. The text of the
+	// sign before and after editing is passed. ctx.Cancel() may be called to keep the old text on the sign.
+	HandleSignEdit(ctx *event.Context, pos world.BlockPos, oldText, newText string)
+	// HandleSleep handles the player going to sleep in the bed with its head at the position passed.
+	// ctx.Cancel() may be called to prevent the player from sleeping.
+	HandleSleep(ctx *event.Context, pos world.BlockPos)
 	// HandleItemUse handles the player using an item in the air. It is called for each item, although most
 	// will not actually do anything. Items such as snowballs may be thrown if HandleItemUse does not cancel
 	// the context using ctx.Cancel(). It is not called if the player is holding no item.
 	HandleItemUse(ctx *event.Context)
+	// HandleItemRelease handles the player releasing an item that it was using, such as a bow that it was
+	// drawing. The item released and the duration that it was used for are passed. ctx.Cancel() may be
+	// called to prevent the item from being released, such as a bow shooting an arrow.
+	HandleItemRelease(ctx *event.Context, item item.Stack, duration time.Duration)
 	// HandleItemUseOnBlock handles the player using the item held in its main hand on a block at the block
 	// position passed. The face of the block clicked is also passed, along with the relative click position.
 	// The click position has X, Y and Z values which are all in the range 0.0-1.0. It is also called if the
@@ -79,6 +91,10 @@ type Handler interface {
 	// HandleItemPickup handles the player picking up an item from the ground. The item stack laying on the
 	// ground is passed. ctx.Cancel() may be called to prevent the player from picking up the item.
 	HandleItemPickup(ctx *event.Context, i item.Stack)
+	// HandleCraft handles the player crafting a recipe in its crafting grid or in a crafting table. The
+	// input of the recipe has been validated when HandleCraft is called. ctx.Cancel() may be called to
+	// prevent the player from crafting the recipe.
+	HandleCraft(ctx *event.Context, r recipe.Recipe)
 	// HandleTransfer handles a player being transferred to another server. ctx.Cancel() may be called to
 	// cancel the transfer.
 	HandleTransfer(ctx *event.Context, addr *net.UDPAddr)
@@ -122,12 +138,21 @@ func (NopHandler) HandleBlockBreak(*event.Context, world.BlockPos) {}
 // HandleBlockPlace ...
 func (NopHandler) HandleBlockPlace(*event.Context, world.BlockPos, world.Block) {}
 
+// HandleSignEdit ...
+func (NopHandler) HandleSignEdit(*event.Context, world.BlockPos, string, string) {}
+
+// HandleSleep ...
+func (NopHandler) HandleSleep(*event.Context, world.BlockPos) {}
+
 // HandleItemPickup ...
 func (NopHandler) HandleItemPickup(*event.Context, item.Stack) {}
 
 // HandleItemUse ...
 func (NopHandler) HandleItemUse(*event.Context) {}
 
+// HandleItemRelease ...
+func (NopHandler) HandleItemRelease(*event.Context, item.Stack, time.Duration) {}
+
 // HandleItemUseOnBlock ...
 func (NopHandler) HandleItemUseOnBlock(*event.Context, world.BlockPos, world.Face, mgl64.Vec3) {
 }
@@ -141,6 +166,9 @@ func (NopHandler) HandleItemDamage(*event.Context, item.Stack, int) {}
 // HandleAttackEntity ...
 func (NopHandler) HandleAttackEntity(*event.Context, world.Entity) {}
 
+// HandleCraft ...
+func (NopHandler) HandleCraft(*event.Context, recipe.Recipe) {}
+
 // HandleHurt ...
 func (NopHandler) HandleHurt(*event.Context, *float64, damage.Source) {}
 
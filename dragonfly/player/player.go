@@ -16,24 +16,29 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/internal/entity_internal"
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/item/armour"
+	"github.com/df-mc/dragonfly/dragonfly/item/enchantment"
 	"github.com/df-mc/dragonfly/dragonfly/item/inventory"
 	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/permission"
 	"github.com/df-mc/dragonfly/dragonfly/player/bossbar"
 	"github.com/df-mc/dragonfly/dragonfly/player/chat"
 	"github.com/df-mc/dragonfly/dragonfly/player/form"
 	"github.com/df-mc/dragonfly/dragonfly/player/scoreboard"
 	"github.com/df-mc/dragonfly/dragonfly/player/skin"
 	"github.com/df-mc/dragonfly/dragonfly/player/title"
+	"github.com/df-mc/dragonfly/dragonfly/recipe"
 	"github.com/df-mc/dragonfly/dragonfly/session"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"github.com/df-mc/dragonfly/dragonfly/world/particle"
 	"github.com/df-mc/dragonfly/dragonfly/world/sound"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
 	"go.uber.org/atomic"
 	"image/color"
+	"math"
 	"math/rand"
 	"net"
 	"strings"
@@ -72,6 +77,8 @@ type Player struct {
 	sneaking, sprinting, swimming, invisible, onGround atomic.Bool
 
 	speed    atomic.Float64
+	moved    atomic.Bool
+	movement *entity.MovementComputer
 	health   *entity_internal.HealthManager
 	effects  *entity.EffectManager
 	immunity atomic.Value
@@ -82,7 +89,32 @@ type Player struct {
 
 	breakParticleCounter atomic.Uint32
 
+	// usingSince is the time in nanoseconds at which the player started using a releasable item, such as a
+	// bow. It is 0 if the player is not currently using an item.
+	usingSince atomic.Int64
+	fireTicks  atomic.Int64
+	// airTicks is the amount of ticks of air that the player has left. It is reduced while the player is
+	// underwater and the player starts drowning once it reaches 0.
+	airTicks atomic.Int64
+	// fallDistance is the distance that the player has fallen since it was last on the ground.
+	fallDistance atomic.Float64
+
+	// permissions holds the *permission.Manager used to check the permissions of the player, if any.
+	permissions atomic.Value
+
 	hunger *hungerManager
+	// experience is the total amount of experience points that the player has collected.
+	experience atomic.Int64
+
+	// sleeping specifies if the player is currently sleeping in the bed with its head at sleepPos. sleepTicks
+	// is the amount of ticks that the player has been sleeping for.
+	sleeping   atomic.Bool
+	sleepPos   atomic.Value
+	sleepTicks atomic.Int64
+	// spawnPoint holds the bedSpawn with the world and position of the bed that the player respawns at. It is
+	// only used if spawnPointSet is true: If not, the player respawns at the spawn of the world.
+	spawnPoint    atomic.Value
+	spawnPointSet atomic.Bool
 }
 
 // New returns a new initialised player. A random UUID is generated for the player, so that it may be
@@ -101,17 +133,21 @@ func New(name string, skin skin.Skin, pos mgl64.Vec3) *Player {
 		hunger:   newHungerManager(),
 		health:   entity_internal.NewHealthManager(),
 		effects:  entity.NewEffectManager(),
+		movement: entity.NewMovementComputer(0.08, false),
 		gameMode: gamemode.Adventure{},
 		h:        NopHandler{},
 		name:     name,
 		skin:     skin,
 		speed:    *atomic.NewFloat64(0.1),
 		nameTag:  *atomic.NewString(name),
+		airTicks: *atomic.NewInt64(maxAirTicks),
 	}
 	p.pos.Store(pos)
 	p.velocity.Store(mgl64.Vec3{})
 	p.immunity.Store(time.Now())
 	p.breakingPos.Store(world.BlockPos{})
+	p.sleepPos.Store(world.BlockPos{})
+	p.spawnPoint.Store(bedSpawn{})
 	return p
 }
 
@@ -158,6 +194,38 @@ func (p *Player) Skin() skin.Skin {
 	return p.skin
 }
 
+// SetPermissionManager sets the permission.Manager used to check the permissions of the player. Without a
+// manager, the player has no permissions at all. If the player is already in a world, the commands available
+// to the player and its operator status are sent to its client again.
+func (p *Player) SetPermissionManager(m *permission.Manager) {
+	p.permissions.Store(m)
+	if p.World() != nil {
+		p.RefreshPermissions()
+	}
+}
+
+// HasPermission checks if the player has the permission node passed, such as 'dragonfly.command.kick'. If
+// the player has no permission.Manager set, HasPermission always returns false.
+func (p *Player) HasPermission(node string) bool {
+	m, _ := p.permissions.Load().(*permission.Manager)
+	if m == nil {
+		return false
+	}
+	return m.Has(p.uuid, node)
+}
+
+// Operator checks if the player is an operator, which is the case if it has the permission.Operator node.
+func (p *Player) Operator() bool {
+	return p.HasPermission(permission.Operator)
+}
+
+// RefreshPermissions sends the commands that the player is permitted to use and its operator status to its
+// client again. It should be called after the permissions of the player change.
+func (p *Player) RefreshPermissions() {
+	p.session().SendAvailableCommands()
+	p.session().SendGameMode(p.GameMode())
+}
+
 // Handle changes the current handler of the player. As a result, events called by the player will call
 // handlers of the Handler passed.
 // Handle sets the player's handler to NopHandler if nil is passed.
@@ -267,7 +335,7 @@ func (p *Player) ExecuteCommand(commandLine string) {
 	ctx := event.C()
 	p.handler().HandleCommandExecution(ctx, command, args[1:])
 	ctx.Continue(func() {
-		command.Execute(strings.TrimPrefix(commandLine, "/"+commandName+" "), p)
+		command.Execute(strings.Join(args[1:], " "), p)
 	})
 }
 
@@ -390,6 +458,12 @@ func (p *Player) Hurt(dmg float64, source damage.Source) {
 		return
 	}
 
+	for _, e := range p.Effects() {
+		if fireResistance, ok := e.(effect.FireResistance); ok && fireResistance.Immune(source) {
+			return
+		}
+	}
+
 	ctx := event.C()
 	p.handler().HandleHurt(ctx, &dmg, source)
 
@@ -411,6 +485,7 @@ func (p *Player) Hurt(dmg float64, source damage.Source) {
 			}
 		}
 		p.addHealth(-finalDamage)
+		p.Wake()
 
 		for _, viewer := range p.World().Viewers(p.Position()) {
 			viewer.ViewEntityAction(p, action.Hurt{})
@@ -450,7 +525,7 @@ func (p *Player) resolveFinalDamage(dmg float64, src damage.Source) float64 {
 			dmg *= resistance.Multiplier(src)
 		}
 	}
-	// TODO: Account for enchantments.
+	dmg -= dmg * p.protectionReduction(src)
 	if dmg < 0 {
 		dmg = 0
 	}
@@ -458,6 +533,44 @@ func (p *Player) resolveFinalDamage(dmg float64, src damage.Source) float64 {
 	return dmg
 }
 
+// protectionReduction returns the fraction that damage from the source passed is reduced by through the
+// protection enchantments on the armour worn by the player. Every level of Protection reduces the damage by
+// 4%, while every level of a specialised protection enchantment reduces damage of its type by 8%. The total
+// reduction is capped at 80%.
+func (p *Player) protectionReduction(src damage.Source) float64 {
+	switch src.(type) {
+	case damage.SourceVoid, damage.SourceStarvation:
+		return 0
+	}
+	points := 0
+	for i := 0; i < 4; i++ {
+		it, _ := p.armour.Inv().Item(i)
+		for _, e := range it.Enchantments() {
+			switch e.(type) {
+			case enchantment.Protection:
+				points += e.Level()
+			case enchantment.ProjectileProtection:
+				if _, ok := src.(damage.SourceProjectile); ok {
+					points += e.Level() * 2
+				}
+			case enchantment.FireProtection:
+				switch src.(type) {
+				case damage.SourceFire, damage.SourceLava:
+					points += e.Level() * 2
+				}
+			case enchantment.BlastProtection:
+				if _, ok := src.(damage.SourceExplosion); ok {
+					points += e.Level() * 2
+				}
+			}
+		}
+	}
+	if points > 20 {
+		points = 20
+	}
+	return float64(points) * 0.04
+}
+
 // SetAbsorption sets the absorption health of a player. This extra health shows as golden hearts and do not
 // actually increase the maximum health. Once the hearts are lost, they will not regenerate.
 // Nothing happens if a negative number is passed.
@@ -481,10 +594,6 @@ func (p *Player) KnockBack(src mgl64.Vec3, force, height float64) {
 	if p.Dead() || !p.survival() {
 		return
 	}
-	if p.session() == session.Nop {
-		// TODO: Implement server-side movement and knock-back.
-		return
-	}
 	velocity := p.Position().Sub(src)
 	velocity[1] = 0
 	velocity = velocity.Normalize().Mul(force)
@@ -496,7 +605,7 @@ func (p *Player) KnockBack(src mgl64.Vec3, force, height float64) {
 			resistance += a.KnockBackResistance()
 		}
 	}
-	p.session().SendVelocity(velocity.Mul(1 - resistance))
+	p.SetVelocity(velocity.Mul(1 - resistance))
 }
 
 // AttackImmune checks if the player is currently immune to entity attacks, meaning it was recently attacked.
@@ -538,6 +647,50 @@ func (p *Player) sendFood() {
 	p.session().SendFood(p.hunger.foodLevel, p.hunger.saturationLevel, p.hunger.exhaustionLevel)
 }
 
+// Experience returns the total amount of experience points that the player has collected. The experience
+// level of the player is derived from this amount, and may be obtained using ExperienceLevel.
+func (p *Player) Experience() int {
+	return int(p.experience.Load())
+}
+
+// ExperienceLevel returns the experience level of the player and the progress towards the next level, which
+// is a value between 0 and 1.
+func (p *Player) ExperienceLevel() (level int, progress float64) {
+	xp := p.Experience()
+	for xp >= experienceForLevel(level) {
+		xp -= experienceForLevel(level)
+		level++
+	}
+	return level, float64(xp) / float64(experienceForLevel(level))
+}
+
+// AddExperience adds a number of experience points to the player. If the amount passed is negative,
+// experience points are removed from the player instead. The total experience of the player will never
+// drop below 0.
+func (p *Player) AddExperience(amount int) {
+	if p.experience.Add(int64(amount)) < 0 {
+		p.experience.Store(0)
+	}
+	p.sendExperience()
+}
+
+// sendExperience sends the current experience level and progress of the player to the client.
+func (p *Player) sendExperience() {
+	p.session().SendExperience(p.ExperienceLevel())
+}
+
+// experienceForLevel returns the amount of experience points needed to go from the level passed to the next
+// level.
+func experienceForLevel(level int) int {
+	switch {
+	case level >= 30:
+		return 9*level - 158
+	case level >= 15:
+		return 5*level - 38
+	}
+	return 2*level + 7
+}
+
 // AddEffect adds an entity.Effect to the Player. If the effect is instant, it is applied to the Player
 // immediately. If not, the effect is applied to the player every time the Tick method is called.
 // AddEffect will overwrite any effects present if the level of the effect is higher than the existing one, or
@@ -612,11 +765,18 @@ func (p *Player) kill(src damage.Source) {
 	}
 
 	p.addHealth(-p.MaxHealth())
+	p.Wake()
 	p.StopSneaking()
 	p.StopSprinting()
-	p.inv.Clear()
-	p.armour.Clear()
-	p.offHand.Clear()
+	p.SetOnFire(0)
+	p.usingSince.Store(0)
+	p.airTicks.Store(maxAirTicks)
+	p.fallDistance.Store(0)
+	if !p.World().GameRule(gamerule.KeepInventory{}).(bool) {
+		p.inv.Clear()
+		p.armour.Clear()
+		p.offHand.Clear()
+	}
 	for _, e := range p.Effects() {
 		p.RemoveEffect(e)
 	}
@@ -646,12 +806,26 @@ func (p *Player) Respawn() {
 	if !p.Dead() || p.World() == nil || p.session() == session.Nop {
 		return
 	}
-	pos := p.World().Spawn().Vec3Middle()
+	w, pos := p.World(), p.World().Spawn().Vec3Middle()
+	if spawnWorld, spawn, ok := p.SpawnPoint(); ok {
+		if bedPos, ok := bedSpawnPosition(spawn, spawnWorld); ok {
+			w, pos = spawnWorld, bedPos
+		} else {
+			// The bed was removed or obstructed, so the spawn point is no longer valid.
+			p.ResetSpawnPoint()
+			p.Message("You have no home bed, or your home bed was missing or obstructed")
+		}
+	}
 	p.handler().HandleRespawn(&pos)
 	p.addHealth(p.MaxHealth())
 	p.hunger.Reset()
 	p.sendFood()
 
+	if w != p.World() {
+		// The bed of the player is in another world than the one it died in, so the player is moved to that
+		// world first.
+		p.TransferToWorld(w, pos)
+	}
 	p.World().AddEntity(p)
 	p.SetVisible()
 
@@ -659,6 +833,76 @@ func (p *Player) Respawn() {
 	p.session().SendRespawn()
 }
 
+// bedSpawn holds the world and position of the head of the bed that a player respawns at.
+type bedSpawn struct {
+	w   *world.World
+	pos world.BlockPos
+}
+
+// SpawnPoint returns the world and position of the bed that the player respawns at after dying. If the player
+// has no spawn point set, the world that the player is in and its spawn are returned and the bool returned
+// is false.
+func (p *Player) SpawnPoint() (w *world.World, pos world.BlockPos, ok bool) {
+	if !p.spawnPointSet.Load() {
+		if w := p.World(); w != nil {
+			return w, w.Spawn(), false
+		}
+		return nil, world.BlockPos{}, false
+	}
+	spawn := p.spawnPoint.Load().(bedSpawn)
+	return spawn.w, spawn.pos, true
+}
+
+// SetSpawnPoint sets the spawn point of the player to the bed with its head at the position passed in the
+// world passed. The player respawns next to that bed after dying, as long as the bed is still present and not
+// obstructed when the player respawns. SetSpawnPoint does nothing if the world passed is nil.
+func (p *Player) SetSpawnPoint(w *world.World, pos world.BlockPos) {
+	if w == nil {
+		return
+	}
+	p.spawnPoint.Store(bedSpawn{w: w, pos: pos})
+	p.spawnPointSet.Store(true)
+}
+
+// ResetSpawnPoint removes the spawn point of the player, so that it respawns at the spawn of its world after
+// dying.
+func (p *Player) ResetSpawnPoint() {
+	p.spawnPointSet.Store(false)
+}
+
+// bedSpawnPosition returns the position next to the bed with its head at the position passed that a player
+// may respawn at. If no bed is present at that position, or if all positions around it are obstructed, the
+// bool returned is false.
+func bedSpawnPosition(pos world.BlockPos, w *world.World) (mgl64.Vec3, bool) {
+	head, foot, ok := block.BedHalves(pos, w)
+	if !ok {
+		return mgl64.Vec3{}, false
+	}
+	for _, half := range []world.BlockPos{foot, head} {
+		for d := world.North; d <= world.East; d++ {
+			side := half.Side(d.Face())
+			if passable(side, w) && passable(side.Side(world.FaceUp), w) && !passable(side.Side(world.FaceDown), w) {
+				return side.Vec3Middle(), true
+			}
+		}
+	}
+	return mgl64.Vec3{}, false
+}
+
+// passable checks if the block at the position passed has no collision boxes, so that a player is able to
+// stand in it.
+func passable(pos world.BlockPos, w *world.World) bool {
+	b := w.Block(pos)
+	if _, ok := b.(world.Liquid); ok {
+		return false
+	}
+	if _, ok := b.(block.Air); ok {
+		return true
+	}
+	aabb, ok := b.(block.AABBer)
+	return ok && len(aabb.AABB(pos, w)) == 0
+}
+
 // StartSprinting makes a player start sprinting, increasing the speed of the player by 30% and making
 // particles show up under the feet. The player will only start sprinting if its food level is high enough.
 // If the player is sneaking when calling StartSprinting, it is stopped from sneaking.
@@ -815,6 +1059,14 @@ func (p *Player) UseItem() {
 	p.handler().HandleItemUse(ctx)
 
 	ctx.Continue(func() {
+		if releasable, ok := i.Item().(item.Releasable); ok {
+			// Releasable items, such as bows, are not used immediately: The player starts using them and they
+			// are used once released.
+			if p.canRelease(releasable) {
+				p.usingSince.Store(time.Now().UnixNano())
+			}
+			return
+		}
 		usable, ok := i.Item().(item.Usable)
 		if !ok {
 			// The item wasn't usable, so we can stop doing anything right away.
@@ -832,6 +1084,81 @@ func (p *Player) UseItem() {
 	})
 }
 
+// ReleaseItem makes the player release the item that it is currently using, such as a bow that is drawn. If
+// the player is not using an item, ReleaseItem does nothing.
+func (p *Player) ReleaseItem() {
+	since := p.usingSince.Swap(0)
+	if since == 0 || p.Dead() {
+		return
+	}
+	i, left := p.HeldItems()
+	releasable, ok := i.Item().(item.Releasable)
+	if !ok {
+		return
+	}
+	duration := time.Since(time.Unix(0, since))
+
+	ctx := event.C()
+	p.handler().HandleItemRelease(ctx, i, duration)
+	ctx.Continue(func() {
+		ctx := &item.UseContext{FirstFunc: p.firstItem}
+		releasable.Release(p, duration, ctx)
+
+		p.SetHeldItems(p.subtractItem(p.damageItem(i, ctx.Damage), ctx.CountSub), left)
+		p.addNewItem(ctx)
+		for _, consumed := range ctx.ConsumedItems {
+			p.consumeItem(consumed)
+		}
+	})
+}
+
+// UsingItem checks if the player is currently using a releasable item, such as a bow that is being drawn.
+func (p *Player) UsingItem() bool {
+	return p.usingSince.Load() != 0
+}
+
+// canRelease checks if the player is able to start using the releasable item passed. Players in creative
+// mode may always use it, while other players must carry at least one of the items it requires.
+func (p *Player) canRelease(releasable item.Releasable) bool {
+	requirements := releasable.Requirements()
+	if !p.survival() || len(requirements) == 0 {
+		return true
+	}
+	for _, req := range requirements {
+		if _, ok := p.firstItem(req.Comparable); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// firstItem returns the first item held by the player for which the comparable function passed returns true.
+// The item held in the off hand is checked before the items in the inventory.
+func (p *Player) firstItem(comparable func(item.Stack) bool) (item.Stack, bool) {
+	if _, left := p.HeldItems(); !left.Empty() && comparable(left) {
+		return left, true
+	}
+	for _, it := range p.inv.Contents() {
+		if comparable(it) {
+			return it, true
+		}
+	}
+	return item.Stack{}, false
+}
+
+// consumeItem removes the item stack passed from the off hand or the inventory of the player, if it is in
+// survival or adventure mode.
+func (p *Player) consumeItem(s item.Stack) {
+	if !p.survival() {
+		return
+	}
+	if _, left := p.HeldItems(); left.Comparable(s) && left.Count() >= s.Count() {
+		_ = p.offHand.RemoveItem(s)
+		return
+	}
+	_ = p.inv.RemoveItem(s)
+}
+
 // UseItemOnBlock uses the item held in the main hand of the player on a block at the position passed. The
 // player is assumed to have clicked the face passed with the relative click position clickPos.
 // If the item could not be used successfully, for example when the position is out of range, the method
@@ -937,6 +1264,9 @@ func (p *Player) AttackEntity(e world.Entity) {
 		if !ok {
 			return
 		}
+		if _, ok := e.(*Player); ok && !p.World().GameRule(gamerule.PVP{}).(bool) {
+			return
+		}
 		if living.AttackImmune() {
 			return
 		}
@@ -968,6 +1298,23 @@ func (p *Player) AttackEntity(e world.Entity) {
 	})
 }
 
+// Craft crafts the recipe passed, provided the player is not dead. HandleCraft is called on the handler of
+// the player, which may cancel the crafting. Craft returns true if the recipe was crafted.
+// Craft does not validate or consume the input of the recipe: This is done by the session of the player.
+func (p *Player) Craft(r recipe.Recipe) bool {
+	if p.Dead() {
+		return false
+	}
+	ctx := event.C()
+	p.handler().HandleCraft(ctx, r)
+
+	crafted := false
+	ctx.Continue(func() {
+		crafted = true
+	})
+	return crafted
+}
+
 // StartBreaking makes the player start breaking the block at the position passed using the item currently
 // held in its main hand.
 // If no block is present at the position, or if the block is out of range, StartBreaking will return
@@ -1157,6 +1504,11 @@ func (p *Player) BreakBlock(pos world.BlockPos) {
 		p.World().BreakBlock(pos)
 		held, left := p.HeldItems()
 
+		if smelter, ok := b.(block.Smelter); ok {
+			// Any experience left in the smelter is given to the player breaking it.
+			p.AddExperience(smelter.CollectExperience())
+		}
+
 		for _, drop := range p.drops(held, b) {
 			itemEntity := entity.NewItem(drop, pos.Vec3Centre())
 			itemEntity.SetVelocity(mgl64.Vec3{rand.Float64()*0.2 - 0.1, 0.2, rand.Float64()*0.2 - 0.1})
@@ -1176,28 +1528,30 @@ func (p *Player) BreakBlock(pos world.BlockPos) {
 	})
 }
 
-// drops returns the drops that the player can get from the block passed using the item held.
+// drops returns the drops that the player can get from the block passed using the item held. The block
+// itself only drops items if the DoTileDrops game rule is enabled.
 func (p *Player) drops(held item.Stack, b world.Block) []item.Stack {
 	t, ok := held.Item().(tool.Tool)
 	if !ok {
 		t = tool.None{}
 	}
+	tileDrops := p.survival() && p.World().GameRule(gamerule.DoTileDrops{}).(bool)
 	var drops []item.Stack
 	if container, ok := b.(block.Container); ok {
 		// If the block is a container, it should drop its inventory contents regardless whether the
 		// player is in creative mode or not.
 		drops = container.Inventory().Contents()
-		if breakable, ok := b.(block.Breakable); ok && p.survival() {
+		if breakable, ok := b.(block.Breakable); ok && tileDrops {
 			if breakable.BreakInfo().Harvestable(t) {
 				drops = breakable.BreakInfo().Drops(t)
 			}
 		}
 		container.Inventory().Clear()
-	} else if breakable, ok := b.(block.Breakable); ok && p.survival() {
+	} else if breakable, ok := b.(block.Breakable); ok && tileDrops {
 		if breakable.BreakInfo().Harvestable(t) {
 			drops = breakable.BreakInfo().Drops(t)
 		}
-	} else if it, ok := b.(world.Item); ok && p.survival() {
+	} else if it, ok := b.(world.Item); ok && tileDrops {
 		drops = []item.Stack{item.NewStack(it, 1)}
 	}
 	return drops
@@ -1223,6 +1577,28 @@ func (p *Player) teleport(pos mgl64.Vec3) {
 		v.ViewEntityTeleport(p, pos)
 	}
 	p.pos.Store(pos)
+	p.fallDistance.Store(0)
+}
+
+// TransferToWorld transfers the player to the world passed, placing it at the position passed. The player is
+// removed from the world it is currently in, hiding it from viewers there, and the chunks of the new world
+// are sent to the player. If the player is already in the world passed, it is simply teleported. The player is
+// woken up first if it is sleeping.
+func (p *Player) TransferToWorld(w *world.World, pos mgl64.Vec3) {
+	p.Wake()
+
+	old := p.World()
+	if old == w {
+		p.teleport(pos)
+		return
+	}
+	p.session().ChangeWorld(w, pos)
+	if old != nil {
+		old.RemoveEntity(p)
+	}
+	p.pos.Store(pos)
+	w.AddEntity(p)
+	p.session().ViewEntityTeleport(p, pos)
 }
 
 // Move moves the player from one position to another in the world, by adding the delta passed to the current
@@ -1239,6 +1615,13 @@ func (p *Player) Move(deltaPos mgl64.Vec3) {
 			v.ViewEntityMovement(p, deltaPos, 0, 0)
 		}
 		p.pos.Store(p.Position().Add(deltaPos))
+		if p.session() != session.Nop {
+			p.velocity.Store(deltaPos)
+			p.moved.Store(true)
+		}
+		if deltaPos[1] < 0 {
+			p.fallDistance.Add(-deltaPos[1])
+		}
 
 		if p.Swimming() {
 			p.Exhaust(0.01 * deltaPos.Len())
@@ -1316,6 +1699,171 @@ func (p *Player) OpenBlockContainer(pos world.BlockPos) {
 	p.session().OpenBlockContainer(pos)
 }
 
+// OpenSign opens the editor of the sign at the position passed, so that the player may edit its text. If no
+// sign is present at that location, OpenSign does nothing.
+// OpenSign will also do nothing if the player has no session connected to it.
+func (p *Player) OpenSign(pos world.BlockPos) {
+	if p.session() == session.Nop {
+		return
+	}
+	if _, ok := p.World().Block(pos).(block.Sign); ok {
+		p.session().OpenSign(pos)
+	}
+}
+
+// EditSign edits the text of the sign at the position passed to the text passed. An error is returned if
+// no sign is present at that position or if the player is unable to reach it.
+// EditSign calls the HandleSignEdit event of the handler of the player, which may cancel the edit.
+func (p *Player) EditSign(pos world.BlockPos, text string) error {
+	w := p.World()
+	sign, ok := w.Block(pos).(block.Sign)
+	if !ok {
+		return fmt.Errorf("no sign at position %v", pos)
+	}
+	if !p.canReach(pos.Vec3Centre()) {
+		return fmt.Errorf("sign at position %v is out of reach", pos)
+	}
+	ctx := event.C()
+	p.handler().HandleSignEdit(ctx, pos, sign.Text, text)
+	ctx.Continue(func() {
+		w.SetBlock(pos, sign.WithText(text))
+	})
+	ctx.Stop(func() {
+		// Send the old text back so that the player no longer sees its own text on the sign.
+		w.SetBlock(pos, sign)
+	})
+	return nil
+}
+
+const (
+	// sleepStart and sleepEnd are the times of the day between which players are able to sleep.
+	sleepStart, sleepEnd = 12542, 23459
+	// sleepDuration is the amount of ticks that all players in a world must be sleeping for before the night
+	// is skipped.
+	sleepDuration = 100
+)
+
+// Sleep makes the player sleep in the bed with its head at the position passed and sets the spawn point of
+// the player to that bed. Players are only able to sleep at night or during thunderstorms, and survival
+// players are unable to sleep if hostile mobs are nearby. A message is sent to the player if it is unable to
+// sleep.
+// The night is skipped once all players in the world have been sleeping for a couple of seconds.
+func (p *Player) Sleep(pos world.BlockPos) {
+	w := p.World()
+	head, foot, ok := block.BedHalves(pos, w)
+	if !ok || p.Dead() || p.Sleeping() {
+		return
+	}
+	if t := w.Time() % 24000; (t < sleepStart || t > sleepEnd) && !w.Thundering() {
+		p.Message("You can only sleep at night")
+		return
+	}
+	if p.survival() && hostileMobsNearby(head, w) {
+		p.Message("You may not rest now; there are monsters nearby")
+		return
+	}
+	if b, _ := w.Block(head).(block.Bed); b.Occupied {
+		p.Message("This bed is occupied")
+		return
+	}
+	ctx := event.C()
+	p.handler().HandleSleep(ctx, head)
+	ctx.Continue(func() {
+		p.SetSpawnPoint(w, head)
+		setBedOccupied(head, w, true)
+		setBedOccupied(foot, w, true)
+
+		p.StopSprinting()
+		p.StopSneaking()
+		p.sleepPos.Store(head)
+		p.sleepTicks.Store(0)
+		p.sleeping.Store(true)
+		p.teleport(head.Vec3().Add(mgl64.Vec3{0.5, 0.5625, 0.5}))
+		p.updateState()
+	})
+}
+
+// Sleeping checks if the player is currently sleeping in a bed.
+func (p *Player) Sleeping() bool {
+	return p.sleeping.Load()
+}
+
+// Wake wakes the player up if it is currently sleeping, making it leave its bed.
+func (p *Player) Wake() {
+	if !p.sleeping.CAS(true, false) {
+		return
+	}
+	w := p.World()
+	if head, foot, ok := block.BedHalves(p.sleepPos.Load().(world.BlockPos), w); ok {
+		setBedOccupied(head, w, false)
+		setBedOccupied(foot, w, false)
+	}
+	for _, v := range w.Viewers(p.Position()) {
+		v.ViewEntityAction(p, action.WakeUp{})
+	}
+	p.updateState()
+}
+
+// tickSleep wakes the player up if the bed that it is sleeping in was removed and skips the night once all
+// players in the world have been sleeping for long enough.
+func (p *Player) tickSleep() {
+	if !p.Sleeping() {
+		return
+	}
+	if _, _, ok := block.BedHalves(p.sleepPos.Load().(world.BlockPos), p.World()); !ok {
+		p.Wake()
+		return
+	}
+	if p.sleepTicks.Inc() >= sleepDuration {
+		// Other players may still be falling asleep, so the night is checked for skipping every tick.
+		skipNight(p.World())
+	}
+}
+
+// skipNight skips the night in the world passed if all players in it that are able to sleep have been
+// sleeping for long enough, waking them up in the morning. Spectators are not required to sleep.
+func skipNight(w *world.World) {
+	var sleepers []*Player
+	for _, e := range w.Entities() {
+		other, ok := e.(*Player)
+		if !ok || other.Dead() || (other.GameMode() == gamemode.Spectator{}) {
+			continue
+		}
+		if !other.Sleeping() || other.sleepTicks.Load() < sleepDuration {
+			return
+		}
+		sleepers = append(sleepers, other)
+	}
+	t := w.Time()
+	w.SetTime(t - t%24000 + 24000)
+	for _, sleeper := range sleepers {
+		sleeper.Wake()
+	}
+}
+
+// hostileMobsNearby checks if any hostile mobs are within 8 blocks horizontally and 5 blocks vertically of
+// the position passed.
+func hostileMobsNearby(pos world.BlockPos, w *world.World) bool {
+	box := physics.NewAABB(pos.Vec3().Sub(mgl64.Vec3{8, 5, 8}), pos.Vec3().Add(mgl64.Vec3{9, 6, 9}))
+	for _, e := range w.EntitiesWithin(box) {
+		if mob, ok := e.(*entity.Mob); ok && !mob.Dead() {
+			if t, ok := mob.Type().(entity.HostileMobType); ok && t.Hostile() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// setBedOccupied changes the Occupied field of the half of a bed at the position passed, if a bed is still
+// present at that position.
+func setBedOccupied(pos world.BlockPos, w *world.World, occupied bool) {
+	if b, ok := w.Block(pos).(block.Bed); ok {
+		b.Occupied = occupied
+		w.SetBlock(pos, b)
+	}
+}
+
 // Ping sends a ping to the player. The method blocks the caller until a response from the client is received,
 // after which the RTT (time from server -> client -> server) will be returned. Because of the blocking nature
 // of this method, this should be called on another goroutine. The latency may be calculated by dividing the
@@ -1344,11 +1892,21 @@ func (p *Player) Tick(current int64) {
 	}
 	if p.checkOnGround() {
 		p.onGround.Store(true)
+		if distance := p.fallDistance.Load(); distance > 0 {
+			p.fallDistance.Store(0)
+			p.land(distance)
+			p.fall(distance)
+		}
 	} else {
 		p.onGround.Store(false)
 	}
+	p.tickMovement()
 	p.tickFood()
+	p.tickFire(current)
+	p.tickEnvironment(current)
+	p.tickAirSupply()
 	p.effects.Tick(p)
+	p.tickSleep()
 	if p.Position()[1] < 0 && p.survival() && current%10 == 0 {
 		p.Hurt(4, damage.SourceVoid{})
 	}
@@ -1374,9 +1932,175 @@ func (p *Player) tickFood() {
 	}
 }
 
+// tickFire ticks the fire that the player is on, if any. Players on fire take damage every second, until the
+// fire runs out or the player enters water.
+func (p *Player) tickFire(current int64) {
+	ticks := p.fireTicks.Load()
+	if ticks <= 0 {
+		return
+	}
+	if _, ok := p.World().Block(world.BlockPosFromVec3(p.Position())).(block.Water); ok || ticks == 1 {
+		p.SetOnFire(0)
+		return
+	}
+	p.fireTicks.Store(ticks - 1)
+	if current%20 == 0 && p.World().GameRule(gamerule.FireDamage{}).(bool) {
+		p.Hurt(1, damage.SourceFire{})
+	}
+}
+
+// tickEnvironment deals damage to the player caused by the blocks it is in. Players in fire or lava are set
+// on fire and damaged, and players with their head inside of a solid block take suffocation damage.
+func (p *Player) tickEnvironment(current int64) {
+	if !p.survival() {
+		return
+	}
+	w, pos := p.World(), p.Position()
+	if _, ok := w.Liquid(world.BlockPosFromVec3(pos)); ok {
+		// Players in liquids do not take fall damage.
+		p.fallDistance.Store(0)
+	}
+	inFire, inLava := false, false
+	aabb := p.AABB().Translate(pos).Grow(-0.001)
+	min, max := aabb.Min(), aabb.Max()
+	for x := math.Floor(min[0]); x <= max[0]; x++ {
+		for y := math.Floor(min[1]); y <= max[1]; y++ {
+			for z := math.Floor(min[2]); z <= max[2]; z++ {
+				switch w.Block(world.BlockPosFromVec3(mgl64.Vec3{x, y, z})).(type) {
+				case block.Fire:
+					inFire = true
+				case block.Lava:
+					inLava = true
+				}
+			}
+		}
+	}
+	fireDamage := w.GameRule(gamerule.FireDamage{}).(bool)
+	if inLava {
+		if p.OnFireDuration() < time.Second*15 {
+			p.SetOnFire(time.Second * 15)
+		}
+		if current%10 == 0 && fireDamage {
+			p.Hurt(4, damage.SourceLava{})
+		}
+	} else if inFire {
+		if p.OnFireDuration() < time.Second*8 {
+			p.SetOnFire(time.Second * 8)
+		}
+		if current%10 == 0 && fireDamage {
+			p.Hurt(1, damage.SourceFire{})
+		}
+	}
+	if current%10 == 0 && p.suffocating() {
+		p.Hurt(1, damage.SourceSuffocation{})
+	}
+}
+
+// suffocating checks if the head of the player is currently inside of a solid block.
+func (p *Player) suffocating() bool {
+	eyePos := p.Position().Add(mgl64.Vec3{0, p.EyeHeight()})
+	bPos := world.BlockPosFromVec3(eyePos)
+	b := p.World().Block(bPos)
+	if _, ok := b.(world.Liquid); ok {
+		return false
+	}
+	aabbList := []physics.AABB{physics.NewAABB(mgl64.Vec3{}, mgl64.Vec3{1, 1, 1})}
+	if aabb, ok := b.(block.AABBer); ok {
+		aabbList = aabb.AABB(bPos, p.World())
+	}
+	for _, aabb := range aabbList {
+		if aabb.Translate(bPos.Vec3()).Vec3Within(eyePos) {
+			return true
+		}
+	}
+	return false
+}
+
+// maxAirTicks is the maximum amount of ticks of air that a player may have.
+const maxAirTicks = 300
+
+// tickAirSupply ticks the air supply of the player. The air supply of players that are unable to breathe
+// is depleted every tick, after which they start drowning. Players that are able to breathe regain their air
+// supply.
+func (p *Player) tickAirSupply() {
+	ticks := p.airTicks.Load()
+	if p.canBreathe() || !p.survival() {
+		if ticks < maxAirTicks {
+			ticks += 5
+			if ticks > maxAirTicks {
+				ticks = maxAirTicks
+			}
+			p.airTicks.Store(ticks)
+			p.updateState()
+		}
+		return
+	}
+	if ticks--; ticks <= -20 {
+		ticks = 0
+		if p.World().GameRule(gamerule.DrowningDamage{}).(bool) {
+			p.Hurt(2, damage.SourceDrowning{})
+		}
+	}
+	p.airTicks.Store(ticks)
+	p.updateState()
+}
+
+// AirSupply returns the air supply that the player has left. The air supply is depleted while the player is
+// underwater, after which the player starts drowning.
+func (p *Player) AirSupply() time.Duration {
+	ticks := p.airTicks.Load()
+	if ticks < 0 {
+		ticks = 0
+	}
+	return time.Duration(ticks) * time.Second / 20
+}
+
+// fall is called when the player lands on the ground after falling the distance passed. Fall damage is dealt
+// for every block fallen beyond the third, reduced by the level of the Jump Boost effect the player has.
+// Players with the Slow Falling effect never take fall damage.
+func (p *Player) fall(distance float64) {
+	if !p.survival() || !p.World().GameRule(gamerule.FallDamage{}).(bool) {
+		return
+	}
+	for _, e := range p.Effects() {
+		switch eff := e.(type) {
+		case effect.JumpBoost:
+			distance -= float64(eff.Level())
+		case effect.SlowFalling:
+			return
+		}
+	}
+	if dmg := math.Ceil(distance - 3); dmg > 0 {
+		p.Hurt(dmg, damage.SourceFall{})
+	}
+}
+
+// land calls EntityLand on the block that the player landed on after falling the distance passed.
+func (p *Player) land(distance float64) {
+	pos := world.BlockPosFromVec3(p.Position().Sub(mgl64.Vec3{0, 0.2}))
+	if lander, ok := p.World().Block(pos).(world.EntityLander); ok {
+		lander.EntityLand(pos, p.World(), p, distance)
+	}
+}
+
+// OnFireDuration returns the duration that the player remains on fire for. If the player is not on fire, 0
+// is returned.
+func (p *Player) OnFireDuration() time.Duration {
+	return time.Duration(p.fireTicks.Load()) * time.Second / 20
+}
+
+// SetOnFire sets the player on fire for the duration passed. The player takes fire damage every second while
+// it is on fire. Passing a duration of 0 extinguishes the player.
+func (p *Player) SetOnFire(duration time.Duration) {
+	before := p.fireTicks.Swap(int64(duration.Seconds() * 20))
+	if (before > 0) != (duration > 0) {
+		p.updateState()
+	}
+}
+
 // regenerate attempts to regenerate half a heart of health, typically caused by a full food bar.
 func (p *Player) regenerate() {
-	if p.Health() == p.MaxHealth() {
+	if p.Health() == p.MaxHealth() || !p.World().GameRule(gamerule.NaturalRegeneration{}).(bool) {
 		return
 	}
 	p.Heal(1, healing.SourceFood{})
@@ -1429,16 +2153,36 @@ func (p *Player) checkOnGround() bool {
 	return false
 }
 
-// Velocity returns the current velocity of the player.
+// Velocity returns the current velocity of the player. For players controlled by a client, this is the
+// movement of the player in the last tick.
 func (p *Player) Velocity() mgl64.Vec3 {
-	// TODO: Implement server-side movement of player entities.
 	return p.velocity.Load().(mgl64.Vec3)
 }
 
-// SetVelocity sets the velocity of the player.
+// SetVelocity sets the velocity of the player. If the player is controlled by a client, the velocity is sent
+// to it so that it moves accordingly. Otherwise, the velocity is applied server-side every tick.
 func (p *Player) SetVelocity(v mgl64.Vec3) {
-	// TODO: Implement server-side movement of player entities.
 	p.velocity.Store(v)
+	p.session().SendVelocity(v)
+}
+
+// tickMovement moves the player according to its velocity, gravity and friction, if it is not controlled by
+// a client. Players controlled by a client move by themselves, so only their velocity is updated.
+func (p *Player) tickMovement() {
+	if p.session() != session.Nop {
+		if !p.moved.CAS(true, false) {
+			p.velocity.Store(mgl64.Vec3{})
+		}
+		return
+	}
+	if p.OnGround() && p.Velocity().ApproxEqual(mgl64.Vec3{}) {
+		return
+	}
+	// The velocity is stored directly here rather than through SetVelocity, as there is no client to send
+	// it to.
+	toMove, velocity := p.movement.Collide(p, p.Velocity())
+	p.velocity.Store(p.movement.ApplyFriction(p.movement.ApplyGravity(velocity)))
+	p.Move(toMove)
 }
 
 // AABB returns the axis aligned bounding box of the player.
@@ -1478,13 +2222,20 @@ func (p *Player) State() (s []state.State) {
 	if p.canBreathe() || !p.survival() {
 		s = append(s, state.Breathing{})
 	}
+	s = append(s, state.AirSupply{Remaining: p.AirSupply(), Max: time.Duration(maxAirTicks) * time.Second / 20})
 	if p.invisible.Load() {
 		s = append(s, state.Invisible{})
 	}
+	if p.OnFireDuration() > 0 {
+		s = append(s, state.OnFire{})
+	}
 	colour, ambient := effect.ResultingColour(p.Effects())
 	if (colour != color.RGBA{}) {
 		s = append(s, state.EffectBearing{ParticleColour: colour, Ambient: ambient})
 	}
+	if p.Sleeping() {
+		s = append(s, state.Sleeping{BedPosition: p.sleepPos.Load().(world.BlockPos)})
+	}
 	s = append(s, state.Named{NameTag: p.nameTag.Load()})
 	return
 }
@@ -1598,6 +2349,8 @@ func (p *Player) canReach(pos mgl64.Vec3) bool {
 // disconnecting of players.
 func (p *Player) close() {
 	p.handler().HandleQuit()
+	// Make sure the bed of the player is no longer occupied once it leaves.
+	p.Wake()
 
 	p.Handle(NopHandler{})
 	chat.Global.Unsubscribe(p)
@@ -1606,10 +2359,13 @@ func (p *Player) close() {
 	s := p.s
 	p.s = nil
 
-	// Clear the inventories so that they no longer hold references to the connection.
-	_ = p.inv.Close()
-	_ = p.offHand.Close()
-	_ = p.armour.Close()
+	if s == nil {
+		// Players with a session have their inventories closed by the session once it is closed, so that
+		// their contents may still be saved.
+		_ = p.inv.Close()
+		_ = p.offHand.Close()
+		_ = p.armour.Close()
+	}
 	p.sMutex.Unlock()
 
 	if p.xuid == "" {
@@ -0,0 +1,236 @@
+package playerdb
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/entity"
+	"github.com/df-mc/dragonfly/dragonfly/entity/effect"
+	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
+	"github.com/df-mc/dragonfly/dragonfly/item/inventory"
+	"github.com/df-mc/dragonfly/dragonfly/player"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/google/uuid"
+	"github.com/sandertv/gophertunnel/minecraft/nbt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// Provider implements a player.Provider that stores the data of every player in a separate NBT file in a
+// directory. The files are named after the UUID of the player they hold the data of.
+type Provider struct {
+	dir string
+}
+
+// Compile time check to make sure Provider implements player.Provider.
+var _ player.Provider = (*Provider)(nil)
+
+// NewProvider creates a new Provider that reads and writes player data in the directory passed. If the
+// directory does not yet exist, it is created.
+func NewProvider(dir string) (*Provider, error) {
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return nil, fmt.Errorf("error creating player data directory: %w", err)
+	}
+	return &Provider{dir: dir}, nil
+}
+
+// Save saves the data of the player with the UUID passed to the file of the player.
+func (p *Provider) Save(id uuid.UUID, d player.Data) error {
+	buf := bytes.NewBuffer(nil)
+	if err := nbt.NewEncoderWithEncoding(buf, nbt.LittleEndian).Encode(encodeData(d)); err != nil {
+		return fmt.Errorf("error encoding player data: %w", err)
+	}
+	if err := ioutil.WriteFile(p.path(id), buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing player data: %w", err)
+	}
+	return nil
+}
+
+// Load loads the data of the player with the UUID passed from the file of the player. If no such file
+// exists, the bool returned is false.
+func (p *Provider) Load(id uuid.UUID) (player.Data, bool, error) {
+	b, err := ioutil.ReadFile(p.path(id))
+	if os.IsNotExist(err) {
+		return player.Data{}, false, nil
+	} else if err != nil {
+		return player.Data{}, false, fmt.Errorf("error reading player data: %w", err)
+	}
+	var m map[string]interface{}
+	if err := nbt.UnmarshalEncoding(b, &m, nbt.LittleEndian); err != nil {
+		return player.Data{}, false, fmt.Errorf("error decoding player data: %w", err)
+	}
+	return decodeData(m), true, nil
+}
+
+// Close ...
+func (p *Provider) Close() error {
+	return nil
+}
+
+// path returns the path of the file that holds the data of the player with the UUID passed.
+func (p *Provider) path(id uuid.UUID) string {
+	return filepath.Join(p.dir, id.String()+".dat")
+}
+
+// encodeData encodes player.Data to a map that may be encoded as NBT.
+func encodeData(d player.Data) map[string]interface{} {
+	effects := make([]map[string]interface{}, 0, len(d.Effects))
+	for _, e := range d.Effects {
+		id, ok := effect_idByEffect(e)
+		if !ok || e.Instant() {
+			continue
+		}
+		effects = append(effects, map[string]interface{}{
+			"Id":            byte(id),
+			"Amplifier":     byte(e.Level() - 1),
+			"Duration":      int32(e.Duration() / (time.Second / 20)),
+			"Ambient":       boolByte(e.AmbientSource()),
+			"ShowParticles": boolByte(e.ShowParticles()),
+		})
+	}
+	m := map[string]interface{}{
+		"World":               d.World,
+		"Pos":                 nbtconv.Vec3ToFloat32Slice(d.Position),
+		"Rotation":            []float32{float32(d.Yaw), float32(d.Pitch)},
+		"Health":              float32(d.Health),
+		"MaxHealth":           float32(d.MaxHealth),
+		"AbsorptionHealth":    float32(d.Absorption),
+		"foodLevel":           int32(d.Food),
+		"foodSaturationLevel": float32(d.Saturation),
+		"foodExhaustionLevel": float32(d.Exhaustion),
+		"XpTotal":             int32(d.Experience),
+		"PlayerGameMode":      gameModeToID(d.GameMode),
+		"ActiveEffects":       effects,
+		"Inventory":           nbtconv.InvToNBT(d.Inventory),
+		"Offhand":             nbtconv.InvToNBT(d.OffHand),
+		"Armor":               nbtconv.InvToNBT(d.Armour.Inv()),
+	}
+	if d.SpawnPoint != nil {
+		m["SpawnX"], m["SpawnY"], m["SpawnZ"] = int32(d.SpawnPoint[0]), int32(d.SpawnPoint[1]), int32(d.SpawnPoint[2])
+		m["SpawnWorld"] = d.SpawnWorld
+	}
+	return m
+}
+
+// decodeData decodes a map decoded from NBT into player.Data.
+func decodeData(m map[string]interface{}) player.Data {
+	d := player.Data{
+		World:      readString(m, "World"),
+		Position:   nbtconv.MapVec3(m, "Pos"),
+		Health:     float64(readFloat32(m, "Health")),
+		MaxHealth:  float64(readFloat32(m, "MaxHealth")),
+		Absorption: float64(readFloat32(m, "AbsorptionHealth")),
+		Food:       int(readInt32(m, "foodLevel")),
+		Saturation: float64(readFloat32(m, "foodSaturationLevel")),
+		Exhaustion: float64(readFloat32(m, "foodExhaustionLevel")),
+		Experience: int(readInt32(m, "XpTotal")),
+		GameMode:   gameModeFromID(readInt32(m, "PlayerGameMode")),
+		Inventory:  inventory.New(36, nil),
+		OffHand:    inventory.New(2, nil),
+		Armour:     inventory.NewArmour(nil),
+	}
+	if rot, _ := m["Rotation"].([]interface{}); len(rot) == 2 {
+		yaw, _ := rot[0].(float32)
+		pitch, _ := rot[1].(float32)
+		d.Yaw, d.Pitch = float64(yaw), float64(pitch)
+	}
+	effects, _ := m["ActiveEffects"].([]interface{})
+	for _, v := range effects {
+		data, _ := v.(map[string]interface{})
+		if e, ok := decodeEffect(data); ok {
+			d.Effects = append(d.Effects, e)
+		}
+	}
+	items, _ := m["Inventory"].([]interface{})
+	nbtconv.InvFromNBT(d.Inventory, items)
+	items, _ = m["Offhand"].([]interface{})
+	nbtconv.InvFromNBT(d.OffHand, items)
+	items, _ = m["Armor"].([]interface{})
+	nbtconv.InvFromNBT(d.Armour.Inv(), items)
+	if _, ok := m["SpawnX"].(int32); ok {
+		d.SpawnPoint = &world.BlockPos{int(readInt32(m, "SpawnX")), int(readInt32(m, "SpawnY")), int(readInt32(m, "SpawnZ"))}
+		d.SpawnWorld = readString(m, "SpawnWorld")
+	}
+	return d
+}
+
+// decodeEffect decodes an effect from the data passed. If the effect ID in the data is not registered, false
+// is returned.
+func decodeEffect(data map[string]interface{}) (entity.Effect, bool) {
+	id, _ := data["Id"].(byte)
+	e, ok := effect_effectByID(int(id))
+	if !ok {
+		return nil, false
+	}
+	amplifier, _ := data["Amplifier"].(byte)
+	duration, _ := data["Duration"].(int32)
+	ambient, _ := data["Ambient"].(byte)
+	showParticles, _ := data["ShowParticles"].(byte)
+
+	return effect.New(e, int(amplifier)+1, time.Duration(duration)*(time.Second/20), ambient == 1, showParticles == 0), true
+}
+
+// gameModeToID converts a game mode to its ID as stored in player data.
+func gameModeToID(mode gamemode.GameMode) int32 {
+	switch mode.(type) {
+	case gamemode.Survival:
+		return 0
+	case gamemode.Creative:
+		return 1
+	case gamemode.Spectator:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// gameModeFromID converts a game mode ID as stored in player data to a game mode.
+func gameModeFromID(id int32) gamemode.GameMode {
+	switch id {
+	case 0:
+		return gamemode.Survival{}
+	case 1:
+		return gamemode.Creative{}
+	case 3:
+		return gamemode.Spectator{}
+	default:
+		return gamemode.Adventure{}
+	}
+}
+
+// boolByte converts a bool to a byte, 1 being true and 0 being false.
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// readFloat32 reads a float32 from a map at the key passed.
+func readFloat32(m map[string]interface{}, key string) float32 {
+	v, _ := m[key].(float32)
+	return v
+}
+
+// readInt32 reads an int32 from a map at the key passed.
+func readInt32(m map[string]interface{}, key string) int32 {
+	v, _ := m[key].(int32)
+	return v
+}
+
+// readString reads a string from a map at the key passed.
+func readString(m map[string]interface{}, key string) string {
+	v, _ := m[key].(string)
+	return v
+}
+
+//go:linkname effect_idByEffect github.com/df-mc/dragonfly/dragonfly/entity/effect.idByEffect
+//noinspection ALL
+func effect_idByEffect(entity.Effect) (int, bool)
+
+//go:linkname effect_effectByID github.com/df-mc/dragonfly/dragonfly/entity/effect.effectByID
+//noinspection ALL
+func effect_effectByID(int) (entity.Effect, bool)
@@ -0,0 +1,40 @@
+package player
+
+import (
+	"github.com/google/uuid"
+	"io"
+)
+
+// Provider represents a value that may provide Data of players, so that their state may be saved when they
+// leave the server and restored when they join it again.
+type Provider interface {
+	io.Closer
+	// Save saves the Data of the player with the UUID passed. If the Data could not be saved, an error is
+	// returned.
+	Save(id uuid.UUID, d Data) error
+	// Load loads the Data of the player with the UUID passed. If no Data was saved for the player, the bool
+	// returned is false. If the Data could not be loaded, an error is returned.
+	Load(id uuid.UUID) (Data, bool, error)
+}
+
+// NopProvider implements a Provider that does not save or load any data. Players using it will always join
+// with a fresh state.
+type NopProvider struct{}
+
+// Compile time check to make sure NopProvider implements Provider.
+var _ Provider = (*NopProvider)(nil)
+
+// Save ...
+func (NopProvider) Save(uuid.UUID, Data) error {
+	return nil
+}
+
+// Load ...
+func (NopProvider) Load(uuid.UUID) (Data, bool, error) {
+	return Data{}, false, nil
+}
+
+// Close ...
+func (NopProvider) Close() error {
+	return nil
+}
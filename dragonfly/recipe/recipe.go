@@ -0,0 +1,112 @@
+package recipe
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/item"
+)
+
+// Recipe is implemented by all recipe types. A recipe holds the input required to obtain its output and the
+// block that the recipe may be performed in.
+type Recipe interface {
+	// Input returns the items required to obtain the output of the recipe. Empty item stacks in the input
+	// represent slots that must be left empty.
+	Input() []item.Stack
+	// Output returns the item that is obtained upon performing the recipe.
+	Output() item.Stack
+	// Block returns the name of the block that the recipe may be performed in, such as 'crafting_table' or
+	// 'furnace'.
+	Block() string
+}
+
+// Shapeless is a recipe that has no particular shape. Its input items may be placed in any slot of the
+// crafting grid in any order.
+type Shapeless struct {
+	recipe
+}
+
+// NewShapeless creates a new shapeless recipe using the input and output passed. The recipe is crafted in
+// the block with the name passed, which is typically 'crafting_table'.
+func NewShapeless(input []item.Stack, output item.Stack, block string) Shapeless {
+	return Shapeless{recipe: recipe{input: input, output: output, block: block}}
+}
+
+// Shaped is a recipe that has a specific shape. Its input items must be placed in the crafting grid in the
+// same shape as the recipe, although the shape may be moved around and mirrored horizontally.
+type Shaped struct {
+	recipe
+	shape Shape
+}
+
+// NewShaped creates a new shaped recipe using the input, output and shape passed. The input holds the items
+// of the shape row by row, so the length of the input must be equal to the width of the shape multiplied by
+// its height. NewShaped panics if this is not the case.
+func NewShaped(input []item.Stack, output item.Stack, shape Shape, block string) Shaped {
+	if len(input) != shape.Width()*shape.Height() {
+		panic("recipe input does not match shape")
+	}
+	return Shaped{recipe: recipe{input: input, output: output, block: block}, shape: shape}
+}
+
+// Shape returns the shape of the recipe.
+func (r Shaped) Shape() Shape {
+	return r.shape
+}
+
+// Furnace is a recipe that is performed in a furnace, blast furnace or smoker. It smelts a single input item
+// into an output item.
+type Furnace struct {
+	recipe
+	experience float64
+}
+
+// NewFurnace creates a new furnace recipe that smelts the input passed into the output passed, rewarding the
+// experience passed for every item smelted. The recipe is performed in the block with the name passed, such
+// as 'furnace', 'blast_furnace' or 'smoker'.
+func NewFurnace(input, output item.Stack, experience float64, block string) Furnace {
+	return Furnace{recipe: recipe{input: []item.Stack{input}, output: output, block: block}, experience: experience}
+}
+
+// Experience returns the experience rewarded for every item smelted using the recipe.
+func (r Furnace) Experience() float64 {
+	return r.experience
+}
+
+// Shape represents the shape of a shaped recipe. It holds the width and height of the shape, which are
+// between 1 and 3.
+type Shape [2]int
+
+// NewShape creates a new shape with the width and height passed.
+func NewShape(width, height int) Shape {
+	return Shape{width, height}
+}
+
+// Width returns the width of the shape.
+func (s Shape) Width() int {
+	return s[0]
+}
+
+// Height returns the height of the shape.
+func (s Shape) Height() int {
+	return s[1]
+}
+
+// recipe implements the Recipe interface. Those methods are shared by all recipe types.
+type recipe struct {
+	input  []item.Stack
+	output item.Stack
+	block  string
+}
+
+// Input ...
+func (r recipe) Input() []item.Stack {
+	return r.input
+}
+
+// Output ...
+func (r recipe) Output() item.Stack {
+	return r.output
+}
+
+// Block ...
+func (r recipe) Block() string {
+	return r.block
+}
@@ -0,0 +1,64 @@
+package recipe
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/internal/block_internal"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+)
+
+// Register registers a recipe so that it may be used by players. Recipes registered are sent to players
+// when they join the server, so Register should be called before the server is started.
+func Register(r Recipe) {
+	if f, ok := r.(Furnace); ok {
+		furnaceRecipes = append(furnaceRecipes, f)
+		return
+	}
+	recipes = append(recipes, r)
+}
+
+// Recipes returns a list of all recipes that have been registered using Register, including the vanilla
+// recipes registered by default. The crafting recipes are listed first, followed by the furnace recipes.
+func Recipes() []Recipe {
+	all := make([]Recipe, 0, len(recipes)+len(furnaceRecipes))
+	all = append(all, recipes...)
+	for _, f := range furnaceRecipes {
+		all = append(all, f)
+	}
+	return all
+}
+
+// ByNetworkID looks up a crafting recipe by the network ID passed. The network ID of a crafting recipe is its
+// index in the list returned by Recipes, plus one. Furnace recipes have no network ID. If found, the recipe is
+// returned and the bool returned is true.
+func ByNetworkID(id uint32) (Recipe, bool) {
+	if id == 0 || int(id) > len(recipes) {
+		return nil, false
+	}
+	return recipes[id-1], true
+}
+
+// Smelt looks up the furnace recipe that smelts the input passed in the block with the name passed, such as
+// 'furnace', 'blast_furnace' or 'smoker'. If found, the recipe is returned and the bool returned is true.
+func Smelt(input item.Stack, block string) (Furnace, bool) {
+	for _, f := range furnaceRecipes {
+		if f.block == block && input.Comparable(f.input[0]) {
+			return f, true
+		}
+	}
+	return Furnace{}, false
+}
+
+// init makes the furnace recipes registered available to furnace blocks.
+func init() {
+	block_internal.Smelt = func(input item.Stack, block string) (item.Stack, float64, bool) {
+		r, ok := Smelt(input, block)
+		return r.output, r.experience, ok
+	}
+}
+
+var (
+	// recipes holds all crafting recipes registered using Register. Their network IDs are derived from their
+	// index in this slice.
+	recipes []Recipe
+	// furnaceRecipes holds all furnace recipes registered using Register.
+	furnaceRecipes []Furnace
+)
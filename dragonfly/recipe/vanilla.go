@@ -0,0 +1,268 @@
+package recipe
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/block/colour"
+	"github.com/df-mc/dragonfly/dragonfly/block/wood"
+	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/item/armour"
+	"github.com/df-mc/dragonfly/dragonfly/item/tool"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+)
+
+// craftingTable is the name of the block that crafting recipes are performed in. Recipes that fit in the
+// 2x2 crafting grid of the inventory are also registered with this block.
+const craftingTable = "crafting_table"
+
+// furnace, blastFurnace and smoker are the names of the blocks that furnace recipes are performed in. Blast
+// furnaces only smelt ores and smokers only smelt food, so those recipes are registered for both them and the
+// furnace.
+const (
+	furnace      = "furnace"
+	blastFurnace = "blast_furnace"
+	smoker       = "smoker"
+)
+
+// init registers the vanilla recipes of the blocks and items implemented by Dragonfly. Vanilla recipes that
+// need a block or item that is not yet implemented, such as string for a bow, are left out until it is.
+// Other recipes may be added using Register.
+func init() {
+	registerWoodRecipes()
+	registerToolRecipes()
+	registerArmourRecipes()
+	registerMaterialRecipes()
+	registerRedstoneRecipes()
+	registerMiscRecipes()
+	registerFurnaceRecipes()
+}
+
+// registerWoodRecipes registers all recipes that involve wood, for every type of wood.
+func registerWoodRecipes() {
+	for _, w := range wood.All() {
+		planks := block.Planks{Wood: w}
+
+		Register(NewShapeless(stacks(block.Log{Wood: w}), item.NewStack(planks, 4), craftingTable))
+		Register(NewShapeless(stacks(block.Log{Wood: w, Stripped: true}), item.NewStack(planks, 4), craftingTable))
+		Register(NewShaped(stacks(planks, planks), item.NewStack(item.Stick{}, 4), NewShape(1, 2), craftingTable))
+		Register(NewShaped(stacks(planks, planks, planks, planks), item.NewStack(block.CraftingTable{}, 1), NewShape(2, 2), craftingTable))
+		Register(NewShaped(stacks(
+			planks, planks, planks,
+			planks, nil, planks,
+			planks, planks, planks,
+		), item.NewStack(block.Chest{}, 1), NewShape(3, 3), craftingTable))
+		Register(NewShaped(stacks(planks, planks, planks), item.NewStack(block.WoodSlab{Wood: w}, 6), NewShape(3, 1), craftingTable))
+		Register(NewShaped(stacks(
+			planks, nil, nil,
+			planks, planks, nil,
+			planks, planks, planks,
+		), item.NewStack(block.WoodStairs{Wood: w}, 4), NewShape(3, 3), craftingTable))
+		Register(NewShapeless(stacks(planks), item.NewStack(block.WoodButton{Wood: w}, 1), craftingTable))
+		Register(NewShaped(stacks(planks, planks), item.NewStack(block.WoodPressurePlate{Wood: w}, 1), NewShape(2, 1), craftingTable))
+		Register(NewShaped(stacks(
+			planks, planks,
+			planks, planks,
+			planks, planks,
+		), item.NewStack(block.WoodDoor{Wood: w}, 3), NewShape(2, 3), craftingTable))
+		Register(NewShaped(stacks(
+			planks, planks, planks,
+			planks, planks, planks,
+		), item.NewStack(block.WoodTrapdoor{Wood: w}, 2), NewShape(3, 2), craftingTable))
+		Register(NewShaped(stacks(
+			item.Stick{}, planks, item.Stick{},
+			item.Stick{}, planks, item.Stick{},
+		), item.NewStack(block.WoodFenceGate{Wood: w}, 1), NewShape(3, 2), craftingTable))
+		Register(NewShaped(stacks(
+			planks, planks, planks,
+			planks, planks, planks,
+			nil, item.Stick{}, nil,
+		), item.NewStack(block.Sign{Wood: w}, 3), NewShape(3, 3), craftingTable))
+
+		for _, c := range colour.All() {
+			wool := block.Wool{Colour: c}
+			Register(NewShaped(stacks(
+				wool, wool, wool,
+				planks, planks, planks,
+			), item.NewStack(block.Bed{Colour: c}, 1), NewShape(3, 2), craftingTable))
+		}
+
+		log := block.Log{Wood: w}
+		Register(NewShaped(stacks(
+			nil, log, nil,
+			log, block.Furnace{}, log,
+			nil, log, nil,
+		), item.NewStack(block.Smoker{}, 1), NewShape(3, 3), craftingTable))
+
+		registerTools(planks, tool.TierWood)
+	}
+}
+
+// registerToolRecipes registers the recipes of all tools that are not made of wood.
+func registerToolRecipes() {
+	registerTools(block.Cobblestone{}, tool.TierStone)
+	registerTools(item.IronIngot{}, tool.TierIron)
+	registerTools(item.GoldIngot{}, tool.TierGold)
+	registerTools(item.Diamond{}, tool.TierDiamond)
+}
+
+// registerTools registers the pickaxe, axe, shovel, hoe and sword recipes of a specific tier, using the material
+// passed as head of the tools.
+func registerTools(m world.Item, tier tool.Tier) {
+	stick := item.Stick{}
+	Register(NewShaped(stacks(
+		m, m, m,
+		nil, stick, nil,
+		nil, stick, nil,
+	), item.NewStack(item.Pickaxe{Tier: tier}, 1), NewShape(3, 3), craftingTable))
+	Register(NewShaped(stacks(
+		m, m,
+		m, stick,
+		nil, stick,
+	), item.NewStack(item.Axe{Tier: tier}, 1), NewShape(2, 3), craftingTable))
+	Register(NewShaped(stacks(m, stick, stick), item.NewStack(item.Shovel{Tier: tier}, 1), NewShape(1, 3), craftingTable))
+	Register(NewShaped(stacks(
+		m, m,
+		nil, stick,
+		nil, stick,
+	), item.NewStack(item.Hoe{Tier: tier}, 1), NewShape(2, 3), craftingTable))
+	Register(NewShaped(stacks(m, m, stick), item.NewStack(item.Sword{Tier: tier}, 1), NewShape(1, 3), craftingTable))
+}
+
+// registerArmourRecipes registers the recipes of leather, iron, gold and diamond armour.
+func registerArmourRecipes() {
+	tiers := []armour.Tier{armour.TierLeather, armour.TierIron, armour.TierGold, armour.TierDiamond}
+	for i, m := range []world.Item{item.Leather{}, item.IronIngot{}, item.GoldIngot{}, item.Diamond{}} {
+		tier := tiers[i]
+		Register(NewShaped(stacks(
+			m, m, m,
+			m, nil, m,
+		), item.NewStack(item.Helmet{Tier: tier}, 1), NewShape(3, 2), craftingTable))
+		Register(NewShaped(stacks(
+			m, nil, m,
+			m, m, m,
+			m, m, m,
+		), item.NewStack(item.Chestplate{Tier: tier}, 1), NewShape(3, 3), craftingTable))
+		Register(NewShaped(stacks(
+			m, m, m,
+			m, nil, m,
+			m, nil, m,
+		), item.NewStack(item.Leggings{Tier: tier}, 1), NewShape(3, 3), craftingTable))
+		Register(NewShaped(stacks(
+			m, nil, m,
+			m, nil, m,
+		), item.NewStack(item.Boots{Tier: tier}, 1), NewShape(3, 2), craftingTable))
+	}
+}
+
+// registerMaterialRecipes registers the recipes of mineral blocks, polished stone, buckets and carpets.
+func registerMaterialRecipes() {
+	blocks := []world.Item{block.IronBlock{}, block.GoldBlock{}, block.DiamondBlock{}, block.EmeraldBlock{}, block.RedstoneBlock{}}
+	for i, m := range []world.Item{item.IronIngot{}, item.GoldIngot{}, item.Diamond{}, item.Emerald{}, block.RedstoneDust{}} {
+		b := blocks[i]
+		Register(NewShaped(stacks(m, m, m, m, m, m, m, m, m), item.NewStack(b, 1), NewShape(3, 3), craftingTable))
+		Register(NewShapeless(stacks(b), item.NewStack(m, 9), craftingTable))
+	}
+	iron := item.IronIngot{}
+	Register(NewShaped(stacks(
+		iron, nil, iron,
+		nil, iron, nil,
+	), item.NewStack(item.Bucket{}, 1), NewShape(3, 2), craftingTable))
+
+	for _, c := range colour.All() {
+		wool := block.Wool{Colour: c}
+		Register(NewShaped(stacks(wool, wool), item.NewStack(block.Carpet{Colour: c}, 3), NewShape(2, 1), craftingTable))
+	}
+
+	polishable := [][2]world.Item{
+		{block.Granite{}, block.Granite{Polished: true}},
+		{block.Diorite{}, block.Diorite{Polished: true}},
+		{block.Andesite{}, block.Andesite{Polished: true}},
+	}
+	for _, p := range polishable {
+		Register(NewShaped(stacks(p[0], p[0], p[0], p[0]), item.NewStack(p[1], 4), NewShape(2, 2), craftingTable))
+	}
+}
+
+// registerRedstoneRecipes registers the recipes of redstone components, doors and trapdoors that are not made
+// of wood.
+func registerRedstoneRecipes() {
+	redstone, stone, iron, gold := block.RedstoneDust{}, block.Stone{}, item.IronIngot{}, item.GoldIngot{}
+	torch := block.RedstoneTorch{}
+
+	Register(NewShaped(stacks(redstone, item.Stick{}), item.NewStack(torch, 1), NewShape(1, 2), craftingTable))
+	Register(NewShaped(stacks(item.Stick{}, block.Cobblestone{}), item.NewStack(block.Lever{}, 1), NewShape(1, 2), craftingTable))
+	Register(NewShaped(stacks(
+		torch, redstone, torch,
+		stone, stone, stone,
+	), item.NewStack(block.Repeater{}, 1), NewShape(3, 2), craftingTable))
+	Register(NewShapeless(stacks(stone), item.NewStack(block.StoneButton{}, 1), craftingTable))
+	Register(NewShaped(stacks(stone, stone), item.NewStack(block.StonePressurePlate{}, 1), NewShape(2, 1), craftingTable))
+	Register(NewShaped(stacks(gold, gold), item.NewStack(block.WeightedPressurePlate{}, 1), NewShape(2, 1), craftingTable))
+	Register(NewShaped(stacks(iron, iron), item.NewStack(block.WeightedPressurePlate{Heavy: true}, 1), NewShape(2, 1), craftingTable))
+	Register(NewShaped(stacks(
+		iron, iron,
+		iron, iron,
+		iron, iron,
+	), item.NewStack(block.IronDoor{}, 3), NewShape(2, 3), craftingTable))
+	Register(NewShaped(stacks(iron, iron, iron, iron), item.NewStack(block.IronTrapdoor{}, 1), NewShape(2, 2), craftingTable))
+}
+
+// registerMiscRecipes registers the recipes of utility blocks, projectiles and crops.
+func registerMiscRecipes() {
+	cobblestone, iron, ironBlock := block.Cobblestone{}, item.IronIngot{}, block.IronBlock{}
+	Register(NewShaped(stacks(
+		cobblestone, cobblestone, cobblestone,
+		cobblestone, nil, cobblestone,
+		cobblestone, cobblestone, cobblestone,
+	), item.NewStack(block.Furnace{}, 1), NewShape(3, 3), craftingTable))
+	Register(NewShaped(stacks(
+		ironBlock, ironBlock, ironBlock,
+		nil, iron, nil,
+		iron, iron, iron,
+	), item.NewStack(block.Anvil{}, 1), NewShape(3, 3), craftingTable))
+	Register(NewShapeless(stacks(iron, item.Flint{}), item.NewStack(item.FlintAndSteel{}, 1), craftingTable))
+	Register(NewShaped(stacks(item.Flint{}, item.Stick{}, item.Feather{}), item.NewStack(item.Arrow{}, 4), NewShape(1, 3), craftingTable))
+
+	slice := item.MelonSlice{}
+	Register(NewShaped(stacks(slice, slice, slice, slice, slice, slice, slice, slice, slice), item.NewStack(block.Melon{}, 1), NewShape(3, 3), craftingTable))
+	Register(NewShapeless(stacks(slice), item.NewStack(block.MelonSeeds{}, 1), craftingTable))
+	Register(NewShapeless(stacks(block.Pumpkin{}), item.NewStack(block.PumpkinSeeds{}, 4), craftingTable))
+}
+
+// registerFurnaceRecipes registers the vanilla smelting recipes of the furnace, blast furnace and smoker.
+func registerFurnaceRecipes() {
+	registerSmelting(item.NewStack(block.IronOre{}, 1), item.NewStack(item.IronIngot{}, 1), 0.7, furnace, blastFurnace)
+	registerSmelting(item.NewStack(block.GoldOre{}, 1), item.NewStack(item.GoldIngot{}, 1), 1, furnace, blastFurnace)
+	registerSmelting(item.NewStack(block.Cobblestone{}, 1), item.NewStack(block.Stone{}, 1), 0.1, furnace)
+	registerSmelting(item.NewStack(block.Sponge{Wet: true}, 1), item.NewStack(block.Sponge{}, 1), 0.15, furnace)
+	registerSmelting(item.NewStack(block.Sand{}, 1), item.NewStack(block.Glass{}, 1), 0.1, furnace)
+	registerSmelting(item.NewStack(block.Sand{Red: true}, 1), item.NewStack(block.Glass{}, 1), 0.1, furnace)
+	registerSmelting(item.NewStack(item.Beef{}, 1), item.NewStack(item.Beef{Cooked: true}, 1), 0.35, furnace, smoker)
+	registerSmelting(item.NewStack(item.Chicken{}, 1), item.NewStack(item.Chicken{Cooked: true}, 1), 0.35, furnace, smoker)
+	for _, c := range colour.All() {
+		registerSmelting(item.NewStack(block.StainedTerracotta{Colour: c}, 1), item.NewStack(block.GlazedTerracotta{Colour: c}, 1), 0.1, furnace)
+	}
+	for _, w := range wood.All() {
+		registerSmelting(item.NewStack(block.Log{Wood: w}, 1), item.NewStack(item.Coal{Charcoal: true}, 1), 0.15, furnace)
+		registerSmelting(item.NewStack(block.Log{Wood: w, Stripped: true}, 1), item.NewStack(item.Coal{Charcoal: true}, 1), 0.15, furnace)
+	}
+}
+
+// registerSmelting registers a furnace recipe that smelts the input passed into the output passed for every
+// block passed.
+func registerSmelting(input, output item.Stack, experience float64, blocks ...string) {
+	for _, b := range blocks {
+		Register(NewFurnace(input, output, experience, b))
+	}
+}
+
+// stacks converts a list of items to a list of item stacks with a count of 1. Nil items are converted to
+// empty item stacks, which represent empty slots in a shaped recipe.
+func stacks(items ...world.Item) []item.Stack {
+	s := make([]item.Stack, len(items))
+	for i, it := range items {
+		if it != nil {
+			s[i] = item.NewStack(it, 1)
+		}
+	}
+	return s
+}
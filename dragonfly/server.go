@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	_ "github.com/df-mc/dragonfly/dragonfly/item" // Imported for compiler directives.
+	"github.com/df-mc/dragonfly/dragonfly/permission"
 	"github.com/df-mc/dragonfly/dragonfly/player"
+	"github.com/df-mc/dragonfly/dragonfly/player/playerdb"
 	"github.com/df-mc/dragonfly/dragonfly/player/skin"
 	"github.com/df-mc/dragonfly/dragonfly/session"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"github.com/df-mc/dragonfly/dragonfly/world/generator"
 	"github.com/df-mc/dragonfly/dragonfly/world/mcdb"
 	"github.com/go-gl/mathgl/mgl32"
@@ -23,6 +26,7 @@ import (
 	"log"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -38,9 +42,14 @@ type Server struct {
 	c        Config
 	log      *logrus.Logger
 	listener *minecraft.Listener
-	world    *world.World
+	worlds   *WorldManager
 	players  chan *player.Player
 
+	playerProviderMu sync.RWMutex
+	playerProvider   player.Provider
+
+	permissions *permission.Manager
+
 	startTime time.Time
 
 	playerMutex sync.RWMutex
@@ -67,10 +76,33 @@ func New(c *Config, log *logrus.Logger) *Server {
 		c:       *c,
 		log:     log,
 		players: make(chan *player.Player),
-		world:   world.New(log, c.World.SimulationDistance),
 		p:       make(map[uuid.UUID]*player.Player),
 		name:    *atomic.NewString(c.Server.Name),
 	}
+	s.worlds = newWorldManager(s, world.New(log, c.World.SimulationDistance))
+	s.playerProvider = player.NopProvider{}
+	if c.Players.SaveData {
+		p, err := playerdb.NewProvider(c.Players.Folder)
+		if err != nil {
+			log.Fatalf("error loading player provider: %v", err)
+		}
+		s.playerProvider = p
+	}
+	var store permission.Store = permission.NopStore{}
+	if c.Permissions.File != "" {
+		store = permission.NewJSONStore(c.Permissions.File)
+	}
+	perms, err := permission.NewManager(store)
+	if err != nil {
+		log.Fatalf("error loading permissions: %v", err)
+	}
+	s.permissions = perms
+	perms.Subscribe(func() {
+		for _, p := range s.Players() {
+			p.RefreshPermissions()
+		}
+	})
+	s.registerCommands()
 	return s
 }
 
@@ -88,10 +120,35 @@ func (server *Server) Accept() (*player.Player, error) {
 	return p, nil
 }
 
-// World returns the world of the server. Players will be spawned in this world and this world will be read
-// from and written to when the world is edited.
+// World returns the default world of the server. Players will be spawned in this world and this world will
+// be read from and written to when the world is edited.
 func (server *Server) World() *world.World {
-	return server.world
+	return server.worlds.DefaultWorld()
+}
+
+// WorldManager returns the WorldManager of the server. It may be used to create, load, unload and look up
+// worlds other than the default world.
+func (server *Server) WorldManager() *WorldManager {
+	return server.worlds
+}
+
+// Permissions returns the permission.Manager of the server. It may be used to manage permission groups and
+// the permissions of players. Changes are saved to the permission file set in the Config and are sent to
+// online players immediately.
+func (server *Server) Permissions() *permission.Manager {
+	return server.permissions
+}
+
+// PlayerProvider changes the player.Provider of the server to the one passed. The provider is used to save the
+// data of players when they leave the server and to restore it when they join again. If nil is passed, the
+// player.NopProvider is set, which does not save or load any data.
+func (server *Server) PlayerProvider(p player.Provider) {
+	if p == nil {
+		p = player.NopProvider{}
+	}
+	server.playerProviderMu.Lock()
+	server.playerProvider = p
+	server.playerProviderMu.Unlock()
 }
 
 // Run runs the server and blocks until it is closed using a call to Close(). When called, the server will
@@ -183,6 +240,21 @@ func (server *Server) Player(uuid uuid.UUID) (*player.Player, bool) {
 	return nil, false
 }
 
+// PlayerByName looks for a player on the server with the name passed. The name is compared case-insensitively.
+// If found, the player is returned and the bool returned holds a true value. If not, the bool returned is
+// false and the player is nil.
+func (server *Server) PlayerByName(name string) (*player.Player, bool) {
+	server.playerMutex.RLock()
+	defer server.playerMutex.RUnlock()
+
+	for _, p := range server.p {
+		if strings.EqualFold(p.Name(), name) {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
 // SetNamef sets the name of the Server, also known as the MOTD. This name is displayed in the server list.
 // The formatting of the name passed follows the rules of fmt.Sprintf.
 func (server *Server) SetNamef(format string, a ...interface{}) {
@@ -207,12 +279,22 @@ func (server *Server) Close() error {
 	server.log.Debug("Disconnecting players...")
 	server.playerMutex.RLock()
 	for _, p := range server.p {
+		// Save the data of the player before disconnecting it, as the disconnection is handled
+		// asynchronously and might not complete before the server is closed.
+		if err := server.provider().Save(p.UUID(), p.Data()); err != nil {
+			server.log.Errorf("error saving data of player %v: %v", p.Name(), err)
+		}
 		p.Disconnect(text.Yellow()(server.c.Server.ShutdownMessage))
 	}
 	server.playerMutex.RUnlock()
 
-	server.log.Debug("Closing world...")
-	if err := server.world.Close(); err != nil {
+	server.log.Debug("Closing player provider...")
+	if err := server.provider().Close(); err != nil {
+		server.log.Errorf("error closing player provider: %v", err)
+	}
+
+	server.log.Debug("Closing worlds...")
+	if err := server.worlds.Close(); err != nil {
 		return err
 	}
 
@@ -267,7 +349,7 @@ func (server *Server) startListening() error {
 // run runs the server, continuously accepting new connections from players. It returns when the server is
 // closed by a call to Close.
 func (server *Server) run() {
-	server.World().Generator(generator.Flat{})
+	server.World().Generator(server.generator())
 	item_registerVanillaCreativeItems()
 	world_registerAllStates()
 
@@ -289,13 +371,13 @@ func (server *Server) handleConn(conn *minecraft.Conn) {
 	data := minecraft.GameData{
 		WorldName:      server.c.World.Name,
 		Blocks:         server.blockEntries(),
-		PlayerPosition: vec64To32(server.world.Spawn().Vec3Centre()),
+		PlayerPosition: vec64To32(server.World().Spawn().Vec3Centre()),
 		PlayerGameMode: 1,
 		// We set these IDs to 1, because that's how the session will treat them.
 		EntityUniqueID:               1,
 		EntityRuntimeID:              1,
-		Time:                         int64(server.world.Time()),
-		GameRules:                    map[string]interface{}{"naturalregeneration": false},
+		Time:                         int64(server.World().Time()),
+		GameRules:                    server.gameRules(),
 		Difficulty:                   2,
 		ServerAuthoritativeMovement:  true,
 		ServerAuthoritativeInventory: true,
@@ -314,8 +396,36 @@ func (server *Server) handleConn(conn *minecraft.Conn) {
 	server.players <- server.createPlayer(id, conn)
 }
 
+// gameRules returns the game rules of the default world of the server in a format that may be sent to
+// clients joining the server.
+func (server *Server) gameRules() map[string]interface{} {
+	rules := make(map[string]interface{})
+	for rule, value := range server.World().GameRules() {
+		switch v := value.(type) {
+		case int:
+			// The protocol only supports bool, uint32 and float32 values for game rules.
+			if v < 0 {
+				v = 0
+			}
+			rules[rule.Name()] = uint32(v)
+		case float64:
+			rules[rule.Name()] = float32(v)
+		default:
+			rules[rule.Name()] = value
+		}
+	}
+	// Health regeneration is handled by the server, so the client must never regenerate health on its own.
+	rules[gamerule.NaturalRegeneration{}.Name()] = false
+	return rules
+}
+
 // handleSessionClose handles the closing of a session. It removes the player of the session from the server.
 func (server *Server) handleSessionClose(controllable session.Controllable) {
+	if p, ok := controllable.(*player.Player); ok {
+		if err := server.provider().Save(p.UUID(), p.Data()); err != nil {
+			server.log.Errorf("error saving data of player %v: %v", p.Name(), err)
+		}
+	}
 	server.playerMutex.Lock()
 	delete(server.p, controllable.UUID())
 	server.playerMutex.Unlock()
@@ -323,13 +433,60 @@ func (server *Server) handleSessionClose(controllable session.Controllable) {
 
 // createPlayer creates a new player instance using the UUID and connection passed.
 func (server *Server) createPlayer(id uuid.UUID, conn *minecraft.Conn) *player.Player {
-	s := session.New(conn, server.c.World.MaximumChunkRadius, server.log)
-	p := player.NewWithSession(conn.IdentityData().DisplayName, conn.IdentityData().XUID, id, server.createSkin(conn.ClientData()), s, server.world.Spawn().Vec3Middle())
-	s.Start(p, server.world, server.handleSessionClose)
+	data, ok, err := server.provider().Load(id)
+	if err != nil {
+		server.log.Errorf("error loading data of player %v: %v", conn.IdentityData().DisplayName, err)
+	}
+	w, pos := server.World(), server.World().Spawn().Vec3Middle()
+	if ok {
+		if saved, found := server.worlds.World(data.World); found {
+			// The player is placed back in the world that it was in when it left. If that world is no longer
+			// loaded, the player is spawned at the spawn of the default world instead.
+			w, pos = saved, data.Position
+		} else if data.World == "" {
+			pos = data.Position
+		}
+		data.Position = pos
+	}
+
+	s := session.New(conn, server.c.World.MaximumChunkRadius, server.log, server.c.Players.MovementTolerance)
+	p := player.NewWithSession(conn.IdentityData().DisplayName, conn.IdentityData().XUID, id, server.createSkin(conn.ClientData()), s, pos)
+	p.SetPermissionManager(server.permissions)
+	s.Start(p, w, server.handleSessionClose)
+	if ok {
+		p.LoadData(data)
+		if data.SpawnPoint != nil && data.SpawnWorld != "" && data.SpawnWorld != w.Name() {
+			// The bed of the player is in another world than the one it joined in, so the spawn point could
+			// not be restored by LoadData. It is dropped if the world is no longer loaded.
+			if spawnWorld, found := server.worlds.World(data.SpawnWorld); found {
+				p.SetSpawnPoint(spawnWorld, *data.SpawnPoint)
+			}
+		}
+	}
 
 	return p
 }
 
+// provider returns the player.Provider of the server.
+func (server *Server) provider() player.Provider {
+	server.playerProviderMu.RLock()
+	defer server.playerProviderMu.RUnlock()
+	return server.playerProvider
+}
+
+// generator returns the world generator selected in the config of the server. If the generator name in the
+// config is unknown, the flat generator is returned.
+func (server *Server) generator() world.Generator {
+	switch strings.ToLower(server.c.World.Generator) {
+	case "overworld":
+		return generator.NewOverworld(server.c.World.Seed)
+	case "flat", "":
+		return generator.Flat{}
+	}
+	server.log.Warnf("Unknown world generator '%v', using flat generator instead.", server.c.World.Generator)
+	return generator.Flat{}
+}
+
 // loadWorld loads the world of the server, ending the program if the world could not be loaded.
 func (server *Server) loadWorld() {
 	server.log.Debug("Loading world...")
@@ -337,8 +494,9 @@ func (server *Server) loadWorld() {
 	if err != nil {
 		server.log.Fatalf("error loading world: %v", err)
 	}
-	server.world.Provider(p)
-	server.log.Debugf("Loaded world '%v'.", server.world.Name())
+	server.World().Provider(p)
+	server.worlds.register(server.World())
+	server.log.Debugf("Loaded world '%v'.", server.World().Name())
 }
 
 // createSkin creates a new skin using the skin data found in the client data in the login, and returns it.
@@ -2,6 +2,7 @@ package session
 
 import (
 	"github.com/df-mc/dragonfly/dragonfly/cmd"
+	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
@@ -32,9 +33,12 @@ func (s *Session) SendCommandOutput(output *cmd.Output) {
 	})
 }
 
-// SendAvailableCommands sends all available commands of the server. Once sent, they will be visible in the
-// /help list and will be auto-completed.
+// SendAvailableCommands sends all commands of the server that the Controllable of the session is able to
+// use. Once sent, they will be visible in the /help list and will be auto-completed.
 func (s *Session) SendAvailableCommands() {
+	if s == Nop {
+		return
+	}
 	commands := cmd.Commands()
 	pk := &packet.AvailableCommands{}
 	for alias, c := range commands {
@@ -42,18 +46,29 @@ func (s *Session) SendAvailableCommands() {
 			// Don't add duplicate entries for aliases.
 			continue
 		}
-		params := c.Params()
-		overloads := make([]protocol.CommandOverload, len(params))
-		for i, params := range params {
+		available := c.Available(s.c)
+		overloads := make([]protocol.CommandOverload, 0, len(available))
+		for i, params := range c.Params() {
+			if !available[i] {
+				// The controllable is not able to use this overload of the command, either because it lacks
+				// the permission to do so or because the overload is limited to other sources.
+				continue
+			}
+			var overload protocol.CommandOverload
 			for _, paramInfo := range params {
 				t, enum := valueToParamType(paramInfo.Value)
 				t |= protocol.CommandArgValid
+				if _, ok := paramInfo.Value.(cmd.SubCommand); ok {
+					// Sub commands are sent as an enum with only the literal as option, so that the client
+					// shows each overload with its literal separately.
+					enum = protocol.CommandEnum{Type: paramInfo.Name, Options: []string{paramInfo.Name}}
+				}
 
 				opt := byte(0)
 				if paramInfo.Value == false || paramInfo.Value == true {
 					opt |= protocol.ParamOptionCollapseEnum
 				}
-				overloads[i].Parameters = append(overloads[i].Parameters, protocol.CommandParameter{
+				overload.Parameters = append(overload.Parameters, protocol.CommandParameter{
 					Name:     paramInfo.Name,
 					Type:     t,
 					Optional: paramInfo.Optional,
@@ -62,6 +77,10 @@ func (s *Session) SendAvailableCommands() {
 					Suffix:   paramInfo.Suffix,
 				})
 			}
+			overloads = append(overloads, overload)
+		}
+		if len(overloads) == 0 {
+			continue
 		}
 		pk.Commands = append(pk.Commands, protocol.Command{
 			Name:        c.Name(),
@@ -88,8 +107,12 @@ func valueToParamType(i interface{}) (t uint32, enum protocol.CommandEnum) {
 			Type:    "bool",
 			Options: []string{"true", "1", "false", "0"},
 		}
-	case mgl64.Vec3:
+	case mgl64.Vec3, world.BlockPos:
 		return protocol.CommandArgTypePosition, enum
+	case cmd.Varargs:
+		return protocol.CommandArgTypeRawText, enum
+	case cmd.Target:
+		return protocol.CommandArgTypeTarget, enum
 	}
 	if param, ok := i.(cmd.Parameter); ok && (param.Type() == "player" || param.Type() == "target") {
 		return protocol.CommandArgTypeTarget, enum
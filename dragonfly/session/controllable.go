@@ -1,9 +1,13 @@
 package session
 
 import (
+	"github.com/df-mc/dragonfly/dragonfly/cmd"
+	"github.com/df-mc/dragonfly/dragonfly/entity"
 	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/permission"
 	"github.com/df-mc/dragonfly/dragonfly/player/form"
 	"github.com/df-mc/dragonfly/dragonfly/player/skin"
+	"github.com/df-mc/dragonfly/dragonfly/recipe"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
 	"github.com/go-gl/mathgl/mgl64"
@@ -17,6 +21,8 @@ type Controllable interface {
 	world.Entity
 	item.Carrier
 	form.Submitter
+	cmd.Source
+	permission.Holder
 
 	Move(deltaPos mgl64.Vec3)
 	Speed() float64
@@ -29,10 +35,16 @@ type Controllable interface {
 	SetGameMode(mode gamemode.GameMode)
 
 	UseItem()
+	ReleaseItem()
 	UseItemOnBlock(pos world.BlockPos, face world.Face, clickPos mgl64.Vec3)
 	UseItemOnEntity(e world.Entity)
 	BreakBlock(pos world.BlockPos)
 	AttackEntity(e world.Entity)
+	// EditSign edits the text of the sign at the position passed. It returns an error if the sign could not
+	// be edited by the controllable.
+	EditSign(pos world.BlockPos, text string) error
+	// Craft crafts the recipe passed. It returns false if the crafting of the recipe was cancelled.
+	Craft(r recipe.Recipe) bool
 
 	Respawn()
 
@@ -45,6 +57,7 @@ type Controllable interface {
 	StartSwimming()
 	Swimming() bool
 	StopSwimming()
+	Wake()
 
 	StartBreaking(pos world.BlockPos)
 	ContinueBreaking(face world.Face)
@@ -52,6 +65,8 @@ type Controllable interface {
 	AbortBreaking()
 
 	Exhaust(points float64)
+	AddExperience(amount int)
+	Effects() []entity.Effect
 
 	// Name returns the display name of the controllable. This name is shown in-game to other viewers of the
 	// world.
@@ -1,7 +1,9 @@
 package session
 
 import (
+	"github.com/df-mc/dragonfly/dragonfly/entity"
 	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
 )
 
 // entityMetadata represents a map that holds metadata associated with an entity. The data held in the map
@@ -20,6 +22,15 @@ func defaultEntityMetadata(e world.Entity) entityMetadata {
 	m[dataKeyPotionColour] = int32(0)
 	m[dataKeyPotionAmbient] = byte(0)
 
+	if _, ok := e.(Controllable); ok {
+		m[dataKeyPlayerFlags] = byte(0)
+		m[dataKeyPlayerBedPosition] = protocol.BlockPos{}
+	}
+	if f, ok := e.(*entity.FallingBlock); ok {
+		rid, _ := world.BlockRuntimeID(f.Block())
+		m[dataKeyVariant] = int32(rid)
+	}
+
 	return m
 }
 
@@ -45,8 +56,12 @@ const (
 	dataKeyAir
 	dataKeyPotionColour
 	dataKeyPotionAmbient
+	dataKeyPlayerFlags       = 26
+	dataKeyPlayerBedPosition = 28
+	dataKeyMaxAir            = 42
 	dataKeyBoundingBoxWidth  = 53
 	dataKeyBoundingBoxHeight = 54
+	dataKeyFuseLength        = 55
 )
 
 //noinspection GoUnusedConst
@@ -57,8 +72,13 @@ const (
 	dataFlagSprinting
 	dataFlagAction
 	dataFlagInvisible
+	dataFlagIgnited           = 10
 	dataFlagNoAI              = 16
 	dataFlagBreathing         = 35
 	dataFlagAffectedByGravity = 48
 	dataFlagSwimming          = 56
 )
+
+// dataPlayerFlagSleep is the index of the flag in the byte stored at dataKeyPlayerFlags that indicates that
+// the player is sleeping.
+const dataPlayerFlagSleep = 1
@@ -0,0 +1,79 @@
+package session
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// BlockActorDataHandler handles an incoming BlockActorData packet from the client, which is sent after the
+// client finishes editing the text of a sign.
+type BlockActorDataHandler struct{}
+
+const (
+	// maxSignLines is the maximum amount of lines that the text of a sign may have.
+	maxSignLines = 4
+	// maxSignLineLength is the maximum amount of characters that a single line of a sign may have. The
+	// client limits lines by their width, so this is an upper bound that is never reached by clients when
+	// editing a sign normally.
+	maxSignLineLength = 50
+)
+
+// Handle ...
+func (*BlockActorDataHandler) Handle(p packet.Packet, s *Session) error {
+	pk := p.(*packet.BlockActorData)
+	pos := world.BlockPos{int(pk.Position[0]), int(pk.Position[1]), int(pk.Position[2])}
+
+	if id, _ := pk.NBTData["id"].(string); id != "Sign" {
+		return fmt.Errorf("expected block actor data of sign at %v, but got ID %v", pos, id)
+	}
+	text, ok := pk.NBTData["Text"].(string)
+	if !ok {
+		return fmt.Errorf("block actor data of sign at %v had no text", pos)
+	}
+	if err := validateSignText(text); err != nil {
+		return fmt.Errorf("invalid text for sign at %v: %w", pos, err)
+	}
+
+	// The cases below may happen during normal play, for example if the sign was broken while the player was
+	// still editing it, so they shouldn't disconnect the client.
+	if !s.signOpened.CAS(true, false) || s.openedSign.Load().(world.BlockPos) != pos {
+		s.log.Debugf("client tried to edit sign at %v without having it opened", pos)
+		return nil
+	}
+	if _, ok := s.c.World().Block(pos).(block.Sign); !ok {
+		s.log.Debugf("client tried to edit sign at %v, but no sign was found there", pos)
+		return nil
+	}
+	if err := s.c.EditSign(pos, text); err != nil {
+		s.log.Debugf("error editing sign at %v: %v", pos, err)
+	}
+	return nil
+}
+
+// validateSignText checks if the text passed is valid text for a sign. It returns an error if the text has
+// too many lines, lines that are too long or contains characters that cannot be typed on a sign.
+func validateSignText(text string) error {
+	if !utf8.ValidString(text) {
+		return fmt.Errorf("text is not valid UTF-8")
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) > maxSignLines {
+		return fmt.Errorf("text has %v lines, but the maximum is %v", len(lines), maxSignLines)
+	}
+	for _, line := range lines {
+		if n := utf8.RuneCountInString(line); n > maxSignLineLength {
+			return fmt.Errorf("line has %v characters, but the maximum is %v", n, maxSignLineLength)
+		}
+		for _, r := range line {
+			if unicode.IsControl(r) {
+				return fmt.Errorf("line contains control character %q", r)
+			}
+		}
+	}
+	return nil
+}
@@ -15,12 +15,14 @@ func (h *ContainerCloseHandler) Handle(p packet.Packet, s *Session) error {
 	switch pk.WindowID {
 	case 0:
 		// Closing of the normal inventory.
+		s.returnCraftingItems()
 		s.writePacket(&packet.ContainerClose{WindowID: 0})
 		s.invOpened = false
 	case byte(s.openedWindowID.Load()):
 		s.closeCurrentContainer()
 	case 0xff:
-		// TODO: Handle closing the crafting grid.
+		// Closing of the crafting grid of the inventory.
+		s.returnCraftingItems()
 	default:
 		return fmt.Errorf("unexpected close request for unopened container %v", pk.WindowID)
 	}
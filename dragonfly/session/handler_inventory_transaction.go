@@ -23,6 +23,10 @@ func (h *InventoryTransactionHandler) Handle(p packet.Packet, s *Session) error
 		return h.handleUseItemOnEntityTransaction(data, s)
 	case *protocol.UseItemTransactionData:
 		return h.handleUseItemTransaction(data, s)
+	case *protocol.ReleaseItemTransactionData:
+		// The client releases the item it was using, for example when it stops drawing a bow.
+		s.c.ReleaseItem()
+		return nil
 	}
 	return fmt.Errorf("unhandled inventory transaction type %T", pk.TransactionData)
 }
@@ -3,6 +3,7 @@ package session
 import (
 	"fmt"
 	"github.com/df-mc/dragonfly/dragonfly/item"
+	"github.com/df-mc/dragonfly/dragonfly/recipe"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
@@ -15,6 +16,20 @@ type ItemStackRequestHandler struct {
 	currentRequest  int32
 	changes         map[byte]map[byte]protocol.StackResponseSlotInfo
 	responseChanges map[int32]map[byte]map[byte]int32
+
+	// craft holds the recipe currently being crafted in a request. It is nil if no recipe is being crafted.
+	craft *craftingState
+}
+
+// craftingState holds the state of a recipe being crafted during a single item stack request. Items consumed
+// from the crafting grid are tracked so that the output may be created once all input has been consumed.
+type craftingState struct {
+	r    recipe.Recipe
+	auto bool
+	// consumed holds the count of items consumed from each slot of the UI inventory.
+	consumed map[int]int
+	// expected holds the slots of the UI inventory that the recipe takes its input from.
+	expected []int
 }
 
 // Handle ...
@@ -39,6 +54,7 @@ func (h *ItemStackRequestHandler) Handle(p packet.Packet, s *Session) error {
 // handleRequest resolves a single item stack request from the client.
 func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s *Session) (err error) {
 	defer func() {
+		h.craft = nil
 		if err != nil {
 			h.reject(req.RequestID, s)
 			return
@@ -47,6 +63,14 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 	}()
 
 	for _, action := range req.Actions {
+		if _, consume := action.(*protocol.ConsumeStackRequestAction); !consume && h.craft != nil {
+			if _, deprecated := action.(*protocol.CraftResultsDeprecatedStackRequestAction); !deprecated {
+				// All input of the recipe has been consumed, so the output of the recipe may be created.
+				if err = h.finishCraft(s); err != nil {
+					return
+				}
+			}
+		}
 		switch a := action.(type) {
 		case *protocol.TakeStackRequestAction:
 			err = h.handleTake(a, s)
@@ -58,8 +82,14 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 			err = h.handleDestroy(a, s)
 		case *protocol.CraftCreativeStackRequestAction:
 			err = h.handleCreativeCraft(a, s)
+		case *protocol.CraftRecipeStackRequestAction:
+			err = h.handleCraft(a.RecipeNetworkID, false, s)
+		case *protocol.AutoCraftRecipeStackRequestAction:
+			err = h.handleCraft(a.RecipeNetworkID, true, s)
+		case *protocol.ConsumeStackRequestAction:
+			err = h.handleConsume(a, s)
 		case *protocol.CraftResultsDeprecatedStackRequestAction:
-			// Don't do anything with this.
+			// Don't do anything with this. The results are computed server-side from the recipe crafted.
 		default:
 			return fmt.Errorf("unhandled stack request action %#v", action)
 		}
@@ -68,6 +98,9 @@ func (h *ItemStackRequestHandler) handleRequest(req protocol.ItemStackRequest, s
 			return
 		}
 	}
+	if h.craft != nil {
+		err = h.finishCraft(s)
+	}
 	return
 }
 
@@ -115,6 +148,185 @@ func (h *ItemStackRequestHandler) handleCreativeCraft(a *protocol.CraftCreativeS
 	return nil
 }
 
+// handleCraft handles the crafting of a recipe with the network ID passed. The recipe is validated against
+// the items currently present in the crafting grid. If auto is true, the recipe is crafted as many times as
+// the input in the crafting grid allows.
+func (h *ItemStackRequestHandler) handleCraft(networkID uint32, auto bool, s *Session) error {
+	r, ok := recipe.ByNetworkID(networkID)
+	if !ok {
+		return fmt.Errorf("recipe with network ID %v does not exist", networkID)
+	}
+	offset, size := s.craftingGrid()
+	grid := make([]item.Stack, size*size)
+	for i := range grid {
+		grid[i], _ = s.ui.Item(offset + i)
+	}
+	slots, ok := matchRecipe(r, grid, size)
+	if !ok {
+		return fmt.Errorf("items in crafting grid do not match recipe with network ID %v", networkID)
+	}
+	if !s.c.Craft(r) {
+		return fmt.Errorf("crafting of recipe with network ID %v was cancelled", networkID)
+	}
+	expected := make([]int, len(slots))
+	for i, slot := range slots {
+		expected[i] = offset + slot
+	}
+	h.craft = &craftingState{r: r, auto: auto, consumed: map[int]int{}, expected: expected}
+	return nil
+}
+
+// handleConsume handles the consuming of an item in the crafting grid as input of the recipe being crafted.
+func (h *ItemStackRequestHandler) handleConsume(a *protocol.ConsumeStackRequestAction, s *Session) error {
+	if h.craft == nil {
+		return fmt.Errorf("client attempted to consume items without crafting a recipe")
+	}
+	if err := h.verifySlot(a.Source, s); err != nil {
+		return fmt.Errorf("source slot out of sync: %w", err)
+	}
+	offset, size := s.craftingGrid()
+	slot := int(a.Source.Slot)
+	if a.Source.ContainerID != containerCraftingGrid || slot < offset || slot >= offset+size*size {
+		return fmt.Errorf("client attempted to consume an item outside of the crafting grid")
+	}
+	i, _ := h.itemInSlot(a.Source, s)
+	if i.Count() < int(a.Count) {
+		return fmt.Errorf("client attempted to consume %v items, but only %v present", a.Count, i.Count())
+	}
+	h.craft.consumed[slot] += int(a.Count)
+	h.setItemInSlot(a.Source, i.Grow(-int(a.Count)), s)
+	return nil
+}
+
+// finishCraft finishes the crafting of the recipe currently being crafted. It checks if the items consumed
+// from the crafting grid match the input of the recipe and creates the output of the recipe.
+func (h *ItemStackRequestHandler) finishCraft(s *Session) error {
+	c := h.craft
+	h.craft = nil
+
+	if len(c.consumed) != len(c.expected) {
+		return fmt.Errorf("client consumed items from %v slots, but recipe requires %v", len(c.consumed), len(c.expected))
+	}
+	times := c.consumed[c.expected[0]]
+	for _, slot := range c.expected {
+		if c.consumed[slot] != times {
+			return fmt.Errorf("client consumed an uneven amount of items from the crafting grid")
+		}
+	}
+	if times == 0 || (times != 1 && !c.auto) {
+		return fmt.Errorf("client consumed input for %v crafts, but only crafted once", times)
+	}
+	output := c.r.Output()
+	output = output.Grow(output.Count()*times - output.Count())
+
+	h.setItemInSlot(protocol.StackRequestSlotInfo{
+		ContainerID:    containerCreativeOutput,
+		Slot:           50,
+		StackNetworkID: item_id(output),
+	}, output, s)
+	return nil
+}
+
+// matchRecipe checks if the items in the crafting grid passed match the input of the recipe passed. The size
+// passed is the width and height of the crafting grid. If the items match, the slots of the grid holding the
+// input items are returned and the bool returned is true.
+func matchRecipe(r recipe.Recipe, grid []item.Stack, size int) ([]int, bool) {
+	switch r := r.(type) {
+	case recipe.Shaped:
+		return matchShaped(r, grid, size)
+	case recipe.Shapeless:
+		return matchShapeless(r, grid)
+	}
+	return nil, false
+}
+
+// matchShaped matches the items in the crafting grid against a shaped recipe. The shape of the recipe may be
+// located anywhere in the grid and may be mirrored horizontally.
+func matchShaped(r recipe.Shaped, grid []item.Stack, size int) ([]int, bool) {
+	minX, minY, maxX, maxY := size, size, -1, -1
+	for i, it := range grid {
+		if it.Empty() {
+			continue
+		}
+		x, y := i%size, i/size
+		if x < minX {
+			minX = x
+		}
+		if x > maxX {
+			maxX = x
+		}
+		if y < minY {
+			minY = y
+		}
+		if y > maxY {
+			maxY = y
+		}
+	}
+	width, height := r.Shape().Width(), r.Shape().Height()
+	if maxX-minX+1 != width || maxY-minY+1 != height {
+		return nil, false
+	}
+	input := r.Input()
+	for _, mirrored := range []bool{false, true} {
+		var slots []int
+		matches := true
+		for y := 0; y < height && matches; y++ {
+			for x := 0; x < width; x++ {
+				inputX := x
+				if mirrored {
+					inputX = width - 1 - x
+				}
+				slot := (minY+y)*size + minX + x
+				want, have := input[y*width+inputX], grid[slot]
+				if want.Empty() != have.Empty() || (!want.Empty() && !sameItem(want, have)) {
+					matches = false
+					break
+				}
+				if !have.Empty() {
+					slots = append(slots, slot)
+				}
+			}
+		}
+		if matches {
+			return slots, true
+		}
+	}
+	return nil, false
+}
+
+// matchShapeless matches the items in the crafting grid against a shapeless recipe. Every input item must be
+// present exactly once in any slot of the grid, and no other items may be present.
+func matchShapeless(r recipe.Shapeless, grid []item.Stack) ([]int, bool) {
+	input := r.Input()
+	used := make([]bool, len(input))
+	var slots []int
+	for slot, have := range grid {
+		if have.Empty() {
+			continue
+		}
+		found := false
+		for i, want := range input {
+			if !used[i] && sameItem(want, have) {
+				used[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+		slots = append(slots, slot)
+	}
+	return slots, len(slots) == len(input)
+}
+
+// sameItem checks if two item stacks hold the same type of item, disregarding their count and any custom
+// data that they might hold.
+func sameItem(a, b item.Stack) bool {
+	id, meta := a.Item().EncodeItem()
+	id2, meta2 := b.Item().EncodeItem()
+	return id == id2 && meta == meta2
+}
+
 // handleDestroy handles the destroying of an item by moving it into the creative inventory.
 func (h *ItemStackRequestHandler) handleDestroy(a *protocol.DestroyStackRequestAction, s *Session) error {
 	if (s.c.GameMode() != gamemode.Creative{} && s.c.GameMode() != gamemode.Spectator{}) {
@@ -137,6 +349,9 @@ func (h *ItemStackRequestHandler) handleTransfer(from, to protocol.StackRequestS
 	if err := h.verifySlots(s, from, to); err != nil {
 		return fmt.Errorf("source slot out of sync: %w", err)
 	}
+	if to.ContainerID == containerFurnaceOutput {
+		return fmt.Errorf("client tried transferring items to the output slot of a furnace")
+	}
 	i, _ := h.itemInSlot(from, s)
 	dest, _ := h.itemInSlot(to, s)
 	if !i.Comparable(dest) {
@@ -155,6 +370,9 @@ func (h *ItemStackRequestHandler) handleTransfer(from, to protocol.StackRequestS
 	h.setItemInSlot(from, i.Grow(-int(count)), s)
 	h.setItemInSlot(to, dest.Grow(int(count)), s)
 
+	if from.ContainerID == containerFurnaceOutput {
+		s.collectExperience()
+	}
 	return nil
 }
 
@@ -54,8 +54,10 @@ func (*PlayerActionHandler) Handle(p packet.Packet, s *Session) error {
 		defer s.swingingArm.Store(false)
 
 		s.c.ContinueBreaking(world.Face(pk.BlockFace))
-	case packet.PlayerActionStartBuildingBlock:
-		// Don't do anything for this action.
+	case packet.PlayerActionStartBuildingBlock, packet.PlayerActionStartSleeping:
+		// Don't do anything for these actions.
+	case packet.PlayerActionStopSleeping:
+		s.c.Wake()
 	default:
 		return fmt.Errorf("unhandled ActionType %v", pk.ActionType)
 	}
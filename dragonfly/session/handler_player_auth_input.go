@@ -20,7 +20,10 @@ func (h PlayerAuthInputHandler) Handle(p packet.Packet, s *Session) error {
 	deltaPos, deltaYaw, deltaPitch := newPos.Sub(s.c.Position()), float64(pk.Yaw)-s.c.Yaw(), float64(pk.Pitch)-s.c.Pitch()
 	if mgl64.FloatEqual(deltaPos.Len(), 0) && mgl64.FloatEqual(deltaYaw, 0) && mgl64.FloatEqual(deltaPitch, 0) {
 		// The PlayerAuthInput packet is sent every tick, so don't do anything if the position and rotation
-		// were unchanged.
+		// were unchanged, unless the player is hovering in the air.
+		if !s.c.OnGround() && !s.teleportPending() && !s.movement.validate(s.c, deltaPos) {
+			s.ViewEntityTeleport(s.c, s.c.Position())
+		}
 		return nil
 	}
 
@@ -37,6 +40,15 @@ func (h PlayerAuthInputHandler) Handle(p packet.Packet, s *Session) error {
 		s.teleportMu.Lock()
 		s.teleportPos = nil
 		s.teleportMu.Unlock()
+		s.movement.reset()
+	}
+	if !s.movement.validate(s.c, deltaPos) {
+		// The movement of the client deviated too much from the movement simulated by the server, so we
+		// teleport the client back to its last valid position.
+		s.log.Debugf("%v: invalid movement %v, correcting client", s.c.Name(), deltaPos)
+		s.c.Rotate(deltaYaw, deltaPitch)
+		s.ViewEntityTeleport(s.c, s.c.Position())
+		return nil
 	}
 
 	_, submergedBefore := s.c.World().Liquid(world.BlockPosFromVec3(s.c.Position().Add(mgl64.Vec3{0, s.c.EyeHeight()})))
@@ -59,3 +71,11 @@ func (h PlayerAuthInputHandler) Handle(p packet.Packet, s *Session) error {
 	})
 	return nil
 }
+
+// teleportPending checks if the session is currently waiting for the client to be teleported to the position
+// it was last teleported to.
+func (s *Session) teleportPending() bool {
+	s.teleportMu.Lock()
+	defer s.teleportMu.Unlock()
+	return s.teleportPos != nil
+}
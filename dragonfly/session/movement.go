@@ -0,0 +1,160 @@
+package session
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity"
+	"github.com/df-mc/dragonfly/dragonfly/entity/effect"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/go-gl/mathgl/mgl64"
+	"math"
+	"sync"
+)
+
+const (
+	// jumpVelocity is the vertical velocity of a player at the start of a jump.
+	jumpVelocity = 0.42
+	// stepHeight is the maximum height of a block that a player can walk onto without jumping.
+	stepHeight = 0.6
+	// sprintJumpBoost is the horizontal speed added when a player jumps while sprinting.
+	sprintJumpBoost = 0.2
+	// groundDrag and airDrag are the factors that the horizontal speed of a player is multiplied with every
+	// tick when on the ground and in the air respectively.
+	groundDrag, airDrag = 0.6 * 0.91, 0.91
+	// airAcceleration is the horizontal acceleration of a player in the air per tick, when not sprinting.
+	airAcceleration = 0.02
+)
+
+// movementSimulator simulates the movement of the Controllable of a Session server-side, so that the movement
+// claimed by the client can be validated. It reuses the collision, gravity and friction of entity movement.
+type movementSimulator struct {
+	mu sync.Mutex
+	c  *entity.MovementComputer
+	// tolerance is the maximum distance that the movement of the client may deviate from the simulated
+	// movement. If negative, movement is never validated.
+	tolerance float64
+
+	// velocity is the velocity set by the server, for example through knock-back. It is added to the movement
+	// that the client is allowed to make and decreases every tick.
+	velocity mgl64.Vec3
+	// lastMove is the last movement of the client that was accepted.
+	lastMove mgl64.Vec3
+	// fallDeviation is the total distance that the client has ended up above the simulated position since it
+	// was last on the ground. A single tick of gravity is smaller than the tolerance, so the deviation is
+	// accumulated to catch clients that hover or fall too slowly over multiple ticks.
+	fallDeviation float64
+}
+
+// newMovementSimulator returns a new movementSimulator that allows deviations up to the tolerance passed.
+func newMovementSimulator(tolerance float64) *movementSimulator {
+	return &movementSimulator{c: entity.NewMovementComputer(0.08, false), tolerance: tolerance}
+}
+
+// setVelocity sets the velocity of the simulated player, typically when the player is knocked back.
+func (m *movementSimulator) setVelocity(velocity mgl64.Vec3) {
+	m.mu.Lock()
+	m.velocity = velocity
+	m.mu.Unlock()
+}
+
+// reset resets the state of the simulator. It should be called after the player is teleported.
+func (m *movementSimulator) reset() {
+	m.mu.Lock()
+	m.velocity, m.lastMove, m.fallDeviation = mgl64.Vec3{}, mgl64.Vec3{}, 0
+	m.c = entity.NewMovementComputer(0.08, false)
+	m.mu.Unlock()
+}
+
+// validate validates the movement passed of the Controllable passed. validate returns false if the movement
+// deviates too much from the movement simulated, in which case the client should be corrected.
+func (m *movementSimulator) validate(c Controllable, deltaPos mgl64.Vec3) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.tolerance < 0 || m.exempt(c) {
+		m.lastMove, m.velocity, m.fallDeviation = deltaPos, mgl64.Vec3{}, 0
+		return true
+	}
+	// Check if the player was on the ground before moving by trying to move it down slightly.
+	m.c.Collide(c, mgl64.Vec3{0, -0.05})
+	onGround := m.c.OnGround()
+
+	expectedY := m.c.ApplyGravity(m.lastMove)[1]
+	if m.velocity[1] > 0 && m.velocity[1] > expectedY {
+		// Only upward velocity, such as from knock-back, allows the client to move up further than simulated.
+		expectedY = m.velocity[1]
+	}
+	if onGround {
+		m.fallDeviation = 0
+		// The client may jump or step up a block if it is on the ground.
+		jump := jumpVelocity
+		for _, e := range c.Effects() {
+			if _, ok := e.(effect.JumpBoost); ok {
+				jump += float64(e.Level()) * 0.1
+			}
+		}
+		if deltaPos[1] > math.Max(expectedY, math.Max(jump, stepHeight))+m.tolerance {
+			return false
+		}
+	} else {
+		// In the air, the client must follow the vertical movement simulated, unless it collides with a block
+		// earlier.
+		expected, _ := m.c.Collide(c, mgl64.Vec3{0, expectedY})
+		if m.fallDeviation = math.Max(m.fallDeviation+deltaPos[1]-expected[1], 0); m.fallDeviation > m.tolerance {
+			return false
+		}
+	}
+
+	// Horizontally, the client may accelerate depending on its speed, and keeps part of its momentum from
+	// the previous tick.
+	drag, acceleration := airDrag, airAcceleration
+	if c.Sprinting() {
+		acceleration *= 1.3
+	}
+	if onGround {
+		// The acceleration on the ground is equal to the speed of the player with the default friction.
+		drag, acceleration = groundDrag, c.Speed()
+		if deltaPos[1] > stepHeight/2 && c.Sprinting() {
+			acceleration += sprintJumpBoost
+		}
+	}
+	lastHorizontal := mgl64.Vec2{m.lastMove[0], m.lastMove[2]}.Len()
+	knockBack := mgl64.Vec2{m.velocity[0], m.velocity[2]}.Len()
+	if (mgl64.Vec2{deltaPos[0], deltaPos[2]}).Len() > lastHorizontal*drag+acceleration+knockBack+m.tolerance {
+		return false
+	}
+
+	// Finally, movement through blocks is never allowed: The movement after colliding with blocks must be
+	// roughly equal to the movement claimed by the client.
+	collided, _ := m.c.Collide(c, deltaPos)
+	if collided.Sub(deltaPos).Len() > m.tolerance {
+		return false
+	}
+
+	m.lastMove = deltaPos
+	m.velocity = m.c.ApplyFriction(m.c.ApplyGravity(m.velocity))
+	if m.velocity[1] < 0 {
+		m.velocity[1] = 0
+	}
+	return true
+}
+
+// exempt checks if the movement of the Controllable passed is exempt from validation, for example because
+// it is able to fly or is in a liquid.
+func (m *movementSimulator) exempt(c Controllable) bool {
+	if mode := c.GameMode(); (mode == gamemode.Creative{} || mode == gamemode.Spectator{}) {
+		return true
+	}
+	for _, e := range c.Effects() {
+		switch e.(type) {
+		case effect.Levitation, effect.SlowFalling:
+			return true
+		}
+	}
+	w, pos := c.World(), c.Position()
+	for _, y := range []float64{0, 1} {
+		if _, ok := w.Liquid(world.BlockPosFromVec3(pos.Add(mgl64.Vec3{0, y}))); ok {
+			return true
+		}
+	}
+	return false
+}
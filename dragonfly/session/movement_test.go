@@ -0,0 +1,63 @@
+package session
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity"
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/go-gl/mathgl/mgl64"
+	"github.com/sirupsen/logrus"
+	"testing"
+)
+
+// testControllable is a Controllable in an empty world of which the movement may be validated by a
+// movementSimulator. Only the methods used by the simulator are implemented.
+type testControllable struct {
+	Controllable
+	w   *world.World
+	pos mgl64.Vec3
+}
+
+func (c *testControllable) World() *world.World         { return c.w }
+func (c *testControllable) Position() mgl64.Vec3        { return c.pos }
+func (c *testControllable) GameMode() gamemode.GameMode { return gamemode.Survival{} }
+func (c *testControllable) Effects() []entity.Effect    { return nil }
+func (c *testControllable) Sprinting() bool             { return false }
+func (c *testControllable) Speed() float64              { return 0.1 }
+func (c *testControllable) AABB() physics.AABB {
+	return physics.NewAABB(mgl64.Vec3{-0.3, 0, -0.3}, mgl64.Vec3{0.3, 1.8, 0.3})
+}
+
+// newTestControllable returns a testControllable high up in the air of a new empty world.
+func newTestControllable() *testControllable {
+	return &testControllable{w: world.New(logrus.New(), 4), pos: mgl64.Vec3{0.5, 100, 0.5}}
+}
+
+func TestMovementSimulatorFalling(t *testing.T) {
+	c := newTestControllable()
+	defer c.w.Close()
+	m := newMovementSimulator(0.1)
+
+	var velocity float64
+	for i := 0; i < 20; i++ {
+		velocity = (velocity - 0.08) * 0.98
+		delta := mgl64.Vec3{0, velocity}
+		if !m.validate(c, delta) {
+			t.Fatalf("falling movement %v was rejected at tick %v", delta, i)
+		}
+		c.pos = c.pos.Add(delta)
+	}
+}
+
+func TestMovementSimulatorHover(t *testing.T) {
+	c := newTestControllable()
+	defer c.w.Close()
+	m := newMovementSimulator(0.1)
+
+	for i := 0; i < 20; i++ {
+		if !m.validate(c, mgl64.Vec3{}) {
+			return
+		}
+	}
+	t.Fatalf("sustained hover in the air was never rejected")
+}
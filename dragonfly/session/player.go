@@ -10,10 +10,13 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/internal/nbtconv"
 	"github.com/df-mc/dragonfly/dragonfly/item"
 	"github.com/df-mc/dragonfly/dragonfly/item/inventory"
+	"github.com/df-mc/dragonfly/dragonfly/permission"
 	"github.com/df-mc/dragonfly/dragonfly/player/form"
 	"github.com/df-mc/dragonfly/dragonfly/player/skin"
+	"github.com/df-mc/dragonfly/dragonfly/recipe"
 	"github.com/df-mc/dragonfly/dragonfly/world"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
@@ -31,6 +34,9 @@ func (s *Session) closeCurrentContainer() {
 	if !s.containerOpened.Load() {
 		return
 	}
+	if s.craftingTableOpened() {
+		s.returnCraftingItems()
+	}
 	s.closeWindow()
 	pos := s.openedPos.Load().(world.BlockPos)
 	if container, ok := s.c.World().Block(pos).(block.Container); ok {
@@ -38,6 +44,40 @@ func (s *Session) closeCurrentContainer() {
 	}
 }
 
+// craftingTableOpened checks if the session currently has a crafting table opened.
+func (s *Session) craftingTableOpened() bool {
+	if !s.containerOpened.Load() {
+		return false
+	}
+	_, ok := s.c.World().Block(s.openedPos.Load().(world.BlockPos)).(block.CraftingTable)
+	return ok
+}
+
+// craftingGrid returns the offset of the crafting grid currently used in the UI inventory and its size. The
+// size is 3 if a crafting table is opened, or 2 for the crafting grid of the inventory.
+func (s *Session) craftingGrid() (offset, size int) {
+	if s.craftingTableOpened() {
+		return craftingTableOffset, 3
+	}
+	return craftingGridOffset, 2
+}
+
+// returnCraftingItems returns all items left in the crafting grid currently in use to the inventory of the
+// session. Items that do not fit in the inventory are dropped on the ground.
+func (s *Session) returnCraftingItems() {
+	offset, size := s.craftingGrid()
+	for slot := offset; slot < offset+size*size; slot++ {
+		it, _ := s.ui.Item(slot)
+		if it.Empty() {
+			continue
+		}
+		_ = s.ui.SetItem(slot, item.Stack{})
+		if n, err := s.inv.AddItem(it); err != nil {
+			s.c.World().AddEntity(entity.NewItem(it.Grow(-n), s.c.Position()))
+		}
+	}
+}
+
 // SendRespawn spawns the controllable of the session client-side in the world, provided it is has died.
 func (s *Session) SendRespawn() {
 	s.writePacket(&packet.Respawn{
@@ -47,6 +87,22 @@ func (s *Session) SendRespawn() {
 	})
 }
 
+// ChangeWorld changes the world that the session views to the world passed, loading chunks around the
+// position passed. All chunks and entities of the old world are unloaded for the client and the chunks of the
+// new world are sent in their place. ChangeWorld must be called before the controllable of the session is
+// removed from its old world.
+func (s *Session) ChangeWorld(w *world.World, pos mgl64.Vec3) {
+	if s == Nop {
+		return
+	}
+	s.closeCurrentContainer()
+	s.chunkLoader.ChangeWorld(w)
+	s.chunkLoader.Move(pos)
+	s.ViewTime(w.Time())
+	s.ViewWeather(w.Raining(), w.Thundering())
+	s.sendGameRules(gameRules(w.GameRules()))
+}
+
 // sendInv sends the inventory passed to the client with the window ID.
 func (s *Session) sendInv(inv *inventory.Inventory, windowID uint32) {
 	pk := &packet.InventoryContent{
@@ -64,13 +120,32 @@ const (
 	containerChest                = 7
 	containerInventoryChestOpened = 12
 	containerCraftingGrid         = 13
+	containerFurnaceFuel          = 23
+	containerFurnaceInput         = 24
+	containerFurnaceOutput        = 25
 	containerHotbar               = 27
 	containerInventory            = 28
 	containerOffHand              = 33
+	containerBlastFurnaceInput    = 44
+	containerSmokerInput          = 45
 	containerCursor               = 58
 	containerCreativeOutput       = 59
 )
 
+const (
+	// craftingGridOffset is the first slot of the 2x2 crafting grid of the inventory in the UI inventory.
+	craftingGridOffset = 28
+	// craftingTableOffset is the first slot of the 3x3 crafting grid of a crafting table in the UI inventory.
+	craftingTableOffset = 32
+	// containerTypeWorkbench is the container type sent when opening a crafting table.
+	containerTypeWorkbench = 1
+	// containerTypeFurnace, containerTypeBlastFurnace and containerTypeSmoker are the container types sent
+	// when opening their respective blocks.
+	containerTypeFurnace      = 2
+	containerTypeBlastFurnace = 27
+	containerTypeSmoker       = 28
+)
+
 // invByID attempts to return an inventory by the ID passed. If found, the inventory is returned and the bool
 // returned is true.
 func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
@@ -86,8 +161,9 @@ func (s *Session) invByID(id int32) (*inventory.Inventory, bool) {
 	case containerArmour:
 		// Armour inventory.
 		return s.armour.Inv(), true
-	case containerChest:
-		// Chests, potentially other containers too.
+	case containerChest, containerFurnaceFuel, containerFurnaceInput, containerFurnaceOutput,
+		containerBlastFurnaceInput, containerSmokerInput:
+		// Chests, furnaces and potentially other containers too.
 		if s.containerOpened.Load() {
 			return s.openedWindow.Load().(*inventory.Inventory), true
 		}
@@ -146,8 +222,32 @@ func (s *Session) SendFood(food int, saturation, exhaustion float64) {
 	})
 }
 
+// SendExperience sends the experience level of the player and the progress towards the next level, a value
+// between 0 and 1, to the client.
+func (s *Session) SendExperience(level int, progress float64) {
+	s.writePacket(&packet.UpdateAttributes{
+		EntityRuntimeID: selfEntityRuntimeID,
+		Attributes: []protocol.Attribute{
+			{
+				Name:  "minecraft:player.level",
+				Value: float32(level),
+				Max:   24791, Min: 0, Default: 0,
+			},
+			{
+				Name:  "minecraft:player.experience",
+				Value: float32(progress),
+				Max:   1, Min: 0, Default: 0,
+			},
+		},
+	})
+}
+
 // SendVelocity sends the velocity of the player to the client.
 func (s *Session) SendVelocity(velocity mgl64.Vec3) {
+	if s == Nop {
+		return
+	}
+	s.movement.setVelocity(velocity)
 	s.writePacket(&packet.SetActorMotion{
 		EntityRuntimeID: selfEntityRuntimeID,
 		Velocity:        vec64To32(velocity),
@@ -267,6 +367,9 @@ func (s *Session) Transfer(ip net.IP, port int) {
 // SendGameMode sends the game mode of the Controllable of the session to the client. It makes sure the right
 // flags are set to create the full game mode.
 func (s *Session) SendGameMode(mode gamemode.GameMode) {
+	if s == Nop {
+		return
+	}
 	flags, id := uint32(0), int32(packet.GameTypeSurvival)
 	switch mode.(type) {
 	case gamemode.Creative:
@@ -278,11 +381,20 @@ func (s *Session) SendGameMode(mode gamemode.GameMode) {
 	case gamemode.Spectator:
 		flags, id = packet.AdventureFlagWorldImmutable|packet.AdventureFlagAllowFlight|packet.AdventureFlagMuted|packet.AdventureFlagNoClip|packet.AdventureFlagNoPVP, packet.GameTypeCreativeSpectator
 	}
+	actions := uint32(packet.ActionPermissionBuildAndMine | packet.ActionPermissionDoorsAndSwitched | packet.ActionPermissionOpenContainers | packet.ActionPermissionAttackPlayers | packet.ActionPermissionAttackMobs)
+	permissionLevel, commandPermissionLevel := uint32(packet.PermissionLevelMember), uint32(packet.CommandPermissionLevelNormal)
+	if s.c.HasPermission(permission.Operator) {
+		// Operators are shown as such in the player list and get access to the operator abilities of the
+		// client, such as teleporting to other players.
+		actions |= packet.ActionPermissionOperator | packet.ActionPermissionTeleport
+		permissionLevel, commandPermissionLevel = packet.PermissionLevelOperator, packet.CommandPermissionLevelOperator
+	}
 	s.writePacket(&packet.AdventureSettings{
-		Flags:             flags,
-		PermissionLevel:   packet.PermissionLevelMember,
-		PlayerUniqueID:    1,
-		ActionPermissions: uint32(packet.ActionPermissionBuildAndMine | packet.ActionPermissionDoorsAndSwitched | packet.ActionPermissionOpenContainers | packet.ActionPermissionAttackPlayers | packet.ActionPermissionAttackMobs),
+		Flags:                  flags,
+		CommandPermissionLevel: commandPermissionLevel,
+		PermissionLevel:        permissionLevel,
+		PlayerUniqueID:         1,
+		ActionPermissions:      actions,
 	})
 	s.writePacket(&packet.SetPlayerGameType{GameType: id})
 }
@@ -349,6 +461,40 @@ func (s *Session) sendGameRules(gameRules map[string]interface{}) {
 	s.writePacket(&packet.GameRulesChanged{GameRules: gameRules})
 }
 
+// ViewGameRule ...
+func (s *Session) ViewGameRule(rule gamerule.GameRule, value interface{}) {
+	s.sendGameRules(gameRules(map[gamerule.GameRule]interface{}{rule: value}))
+}
+
+// gameRules converts the game rules passed to a map that may be sent to the client.
+func gameRules(rules map[gamerule.GameRule]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(rules))
+	for rule, value := range rules {
+		if _, ok := rule.(gamerule.NaturalRegeneration); ok {
+			// Health regeneration is handled by the server, so the client must never regenerate health on its
+			// own.
+			continue
+		}
+		m[rule.Name()] = gameRuleValue(value)
+	}
+	return m
+}
+
+// gameRuleValue converts the value of a game rule to a type that may be sent to the client. The protocol only
+// supports bool, uint32 and float32 values, so ints and float64s are converted to the latter two.
+func gameRuleValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case int:
+		if v < 0 {
+			return uint32(0)
+		}
+		return uint32(v)
+	case float64:
+		return float32(v)
+	}
+	return value
+}
+
 // EnableCoordinates will either enable or disable coordinates for the player depending on the value given.
 func (s *Session) EnableCoordinates(enable bool) {
 	//noinspection SpellCheckingInspection
@@ -532,6 +678,54 @@ func creativeItems() []protocol.CreativeItem {
 	return it
 }
 
+// recipes returns all registered recipes as protocol recipes, so that they may be sent in a CraftingData
+// packet.
+func recipes() []protocol.Recipe {
+	r := make([]protocol.Recipe, 0, len(recipe.Recipes()))
+	// networkID is the network ID of the last crafting recipe added. Crafting recipes are numbered from 1 in
+	// the order that they are returned, matching recipe.ByNetworkID. Furnace recipes have no network ID.
+	var networkID uint32
+	for _, rec := range recipe.Recipes() {
+		input := make([]protocol.ItemStack, 0, len(rec.Input()))
+		for _, i := range rec.Input() {
+			input = append(input, stackFromItem(i))
+		}
+		output := []protocol.ItemStack{stackFromItem(rec.Output())}
+
+		switch rec := rec.(type) {
+		case recipe.Shapeless:
+			networkID++
+			r = append(r, &protocol.ShapelessRecipe{
+				RecipeID:        uuid.New().String(),
+				Input:           input,
+				Output:          output,
+				UUID:            uuid.New(),
+				Block:           rec.Block(),
+				RecipeNetworkID: networkID,
+			})
+		case recipe.Shaped:
+			networkID++
+			r = append(r, &protocol.ShapedRecipe{
+				RecipeID:        uuid.New().String(),
+				Width:           int32(rec.Shape().Width()),
+				Height:          int32(rec.Shape().Height()),
+				Input:           input,
+				Output:          output,
+				UUID:            uuid.New(),
+				Block:           rec.Block(),
+				RecipeNetworkID: networkID,
+			})
+		case recipe.Furnace:
+			r = append(r, &protocol.FurnaceDataRecipe{
+				InputType: input[0].ItemType,
+				Output:    output[0],
+				Block:     rec.Block(),
+			})
+		}
+	}
+	return r
+}
+
 // The following functions use the go:linkname directive in order to make sure the item.byID and item.toID
 // functions do not need to be exported.
 
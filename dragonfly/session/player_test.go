@@ -0,0 +1,33 @@
+package session
+
+import (
+	"bytes"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"github.com/sandertv/gophertunnel/minecraft/protocol"
+	"github.com/sirupsen/logrus"
+	"testing"
+)
+
+func TestGameRulesRoundTrip(t *testing.T) {
+	w := world.New(logrus.New(), 4)
+	defer w.Close()
+
+	rules := gameRules(w.GameRules())
+	buf := bytes.NewBuffer(nil)
+	if err := protocol.WriteGameRules(buf, rules); err != nil {
+		t.Fatalf("error writing game rules: %v", err)
+	}
+	decoded := make(map[string]interface{})
+	if err := protocol.GameRules(buf, &decoded); err != nil {
+		t.Fatalf("error reading game rules: %v", err)
+	}
+	for name, value := range rules {
+		if decoded[name] != value {
+			t.Errorf("game rule %v: expected %v (%T), got %v (%T)", name, value, value, decoded[name], decoded[name])
+		}
+	}
+	if v := decoded[gamerule.RandomTickSpeed{}.Name()]; v != uint32(3) {
+		t.Errorf("expected randomtickspeed to be sent as uint32(3), got %v (%T)", v, v)
+	}
+}
@@ -41,6 +41,10 @@ type Session struct {
 	teleportMu  sync.Mutex
 	teleportPos *mgl64.Vec3
 
+	// movement simulates the movement of the controllable of the session, so that the movement sent by the
+	// client may be validated.
+	movement *movementSimulator
+
 	// currentEntityRuntimeID holds the runtime ID assigned to the last entity. It is incremented for every
 	// entity spawned to the session.
 	currentEntityRuntimeID atomic.Uint64
@@ -57,6 +61,8 @@ type Session struct {
 	openedWindowID                 atomic.Uint32
 	inTransaction, containerOpened atomic.Bool
 	openedWindow, openedPos        atomic.Value
+	signOpened                     atomic.Bool
+	openedSign                     atomic.Value
 
 	swingingArm atomic.Bool
 
@@ -89,7 +95,7 @@ var ErrSelfRuntimeID = errors.New("invalid entity runtime ID: runtime ID for sel
 // packets that it receives.
 // New takes the connection from which to accept packets. It will start handling these packets after a call to
 // Session.Start().
-func New(conn *minecraft.Conn, maxChunkRadius int, log *logrus.Logger) *Session {
+func New(conn *minecraft.Conn, maxChunkRadius int, log *logrus.Logger, movementTolerance float64) *Session {
 	r := conn.ChunkRadius()
 	if r > maxChunkRadius {
 		r = maxChunkRadius
@@ -111,6 +117,7 @@ func New(conn *minecraft.Conn, maxChunkRadius int, log *logrus.Logger) *Session
 		log:                    log,
 		currentEntityRuntimeID: *atomic.NewUint64(1),
 		heldSlot:               atomic.NewUint32(0),
+		movement:               newMovementSimulator(movementTolerance),
 	}
 	s.scoreboardObj.Store("")
 	s.openedWindow.Store(inventory.New(1, nil))
@@ -130,7 +137,7 @@ func (s *Session) Start(c Controllable, w *world.World, onStop func(controllable
 	s.entities[selfEntityRuntimeID] = c
 
 	s.chunkLoader = world.NewLoader(int(s.chunkRadius), w, s)
-	s.chunkLoader.Move(w.Spawn().Vec3Middle())
+	s.chunkLoader.Move(c.Position())
 
 	s.initPlayerList()
 
@@ -138,6 +145,7 @@ func (s *Session) Start(c Controllable, w *world.World, onStop func(controllable
 	s.c.SetGameMode(w.DefaultGameMode())
 	s.SendAvailableCommands()
 	s.SendSpeed(0.1)
+	s.ViewWeather(w.Raining(), w.Thundering())
 
 	go s.handlePackets()
 
@@ -145,12 +153,14 @@ func (s *Session) Start(c Controllable, w *world.World, onStop func(controllable
 	chat.Global.Println(yellow(s.conn.IdentityData().DisplayName, "has joined the game"))
 
 	s.writePacket(&packet.CreativeContent{Items: creativeItems()})
+	s.writePacket(&packet.CraftingData{Recipes: recipes(), ClearRecipes: true})
 }
 
 // Close closes the session, which in turn closes the controllable and the connection that the session
 // manages.
 func (s *Session) Close() error {
 	s.closeCurrentContainer()
+	s.returnCraftingItems()
 
 	_ = s.conn.Close()
 	_ = s.chunkLoader.Close()
@@ -173,6 +183,12 @@ func (s *Session) Close() error {
 		s.onStop(s.c)
 		s.onStop = nil
 	}
+
+	// Clear the inventories so that they no longer hold references to the connection. This is done after
+	// calling onStop so that their contents may still be saved.
+	_ = s.inv.Close()
+	_ = s.offHand.Close()
+	_ = s.armour.Close()
 	return nil
 }
 
@@ -275,6 +291,7 @@ func (s *Session) registerHandlers() {
 	s.handlers = map[uint32]packetHandler{
 		packet.IDActorFall:             nil,
 		packet.IDAnimate:               nil,
+		packet.IDBlockActorData:        &BlockActorDataHandler{},
 		packet.IDBossEvent:             nil,
 		packet.IDClientCacheBlobStatus: &ClientCacheBlobStatusHandler{},
 		packet.IDCommandRequest:        &CommandRequestHandler{},
@@ -20,6 +20,8 @@ import (
 	"github.com/sandertv/gophertunnel/minecraft/nbt"
 	"github.com/sandertv/gophertunnel/minecraft/protocol"
 	"github.com/sandertv/gophertunnel/minecraft/protocol/packet"
+	"math"
+	"time"
 )
 
 // ViewChunk ...
@@ -79,7 +81,7 @@ func (s *Session) sendBlobHashes(pos world.ChunkPos, c *chunk.Chunk, blockEntiti
 	for pos, b := range blockEntities {
 		data := b.(world.NBTer).EncodeNBT()
 		data["x"], data["y"], data["z"] = int32(pos[0]), int32(pos[1]), int32(pos[2])
-		_ = enc.Encode(enc)
+		_ = enc.Encode(data)
 	}
 
 	s.writePacket(&packet.LevelChunk{
@@ -119,7 +121,7 @@ func (s *Session) sendNetworkChunk(pos world.ChunkPos, c *chunk.Chunk, blockEnti
 	for pos, b := range blockEntities {
 		data := b.(world.NBTer).EncodeNBT()
 		data["x"], data["y"], data["z"] = int32(pos[0]), int32(pos[1]), int32(pos[2])
-		_ = enc.Encode(enc)
+		_ = enc.Encode(data)
 	}
 
 	s.writePacket(&packet.LevelChunk{
@@ -174,19 +176,39 @@ func (s *Session) ViewEntity(e world.Entity) {
 			Position:        vec64To32(v.Position()),
 		})
 	default:
+		var attributes []protocol.Attribute
+		if living, ok := e.(entity.Living); ok {
+			attributes = append(attributes, protocol.Attribute{
+				Name:    "minecraft:health",
+				Value:   float32(math.Ceil(living.Health())),
+				Max:     float32(math.Ceil(living.MaxHealth())),
+				Default: float32(math.Ceil(living.MaxHealth())),
+			})
+		}
 		s.writePacket(&packet.AddActor{
 			EntityUniqueID:  int64(runtimeID),
 			EntityRuntimeID: runtimeID,
-			// TODO: Add methods for entity types.
-			EntityType: "",
-			Position:   vec64To32(e.Position()),
-			Pitch:      float32(e.Pitch()),
-			Yaw:        float32(e.Yaw()),
-			HeadYaw:    float32(e.Yaw()),
+			EntityType:      entityType(e),
+			Position:        vec64To32(e.Position()),
+			Velocity:        vec64To32(e.Velocity()),
+			Pitch:           float32(e.Pitch()),
+			Yaw:             float32(e.Yaw()),
+			HeadYaw:         float32(e.Yaw()),
+			Attributes:      attributes,
+			EntityMetadata:  defaultEntityMetadata(e),
 		})
 	}
 }
 
+// entityType returns the network identifier of the entity passed, such as 'minecraft:zombie'. The identifier
+// is equal to the save ID of the entity, so entities that have an EncodeEntity method have their type sent.
+func entityType(e world.Entity) string {
+	if typed, ok := e.(interface{ EncodeEntity() string }); ok {
+		return typed.EncodeEntity()
+	}
+	return ""
+}
+
 // HideEntity ...
 func (s *Session) HideEntity(e world.Entity) {
 	if s.entityRuntimeID(e) == selfEntityRuntimeID {
@@ -233,7 +255,7 @@ func (s *Session) ViewEntityMovement(e world.Entity, deltaPos mgl64.Vec3, deltaY
 		s.writePacket(&packet.MoveActorAbsolute{
 			EntityRuntimeID: id,
 			Position:        vec64To32(e.Position().Add(deltaPos).Add(mgl64.Vec3{0, entityOffset(e)})),
-			Rotation:        vec64To32(mgl64.Vec3{e.Pitch() + deltaPitch, e.Yaw() + deltaYaw}),
+			Rotation:        vec64To32(mgl64.Vec3{e.Pitch() + deltaPitch, e.Yaw() + deltaYaw, e.Yaw() + deltaYaw}),
 			Flags:           flags,
 		})
 	}
@@ -255,6 +277,21 @@ func (s *Session) ViewTime(time int) {
 	s.writePacket(&packet.SetTime{Time: int32(time)})
 }
 
+// ViewWeather ...
+func (s *Session) ViewWeather(raining, thunder bool) {
+	pk := &packet.LevelEvent{EventType: packet.EventStopRain}
+	if raining {
+		pk.EventType, pk.EventData = packet.EventStartRain, 65535
+	}
+	s.writePacket(pk)
+
+	pk = &packet.LevelEvent{EventType: packet.EventStopThunder}
+	if thunder {
+		pk.EventType, pk.EventData = packet.EventStartThunder, 65535
+	}
+	s.writePacket(pk)
+}
+
 // ViewEntityTeleport ...
 func (s *Session) ViewEntityTeleport(e world.Entity, position mgl64.Vec3) {
 	id := s.entityRuntimeID(e)
@@ -338,6 +375,13 @@ func (s *Session) ViewEntityArmour(e world.Entity) {
 	})
 }
 
+// particleHugeExplosionSeed is the ID of the huge explosion particle, which may be spawned using a LevelEvent
+// packet with the EventAddParticleMask.
+const particleHugeExplosionSeed = 16
+
+// eventParticleCropGrowth is the ID of the LevelEvent that shows the particles of bone meal being used.
+const eventParticleCropGrowth = 2005
+
 // ViewParticle ...
 func (s *Session) ViewParticle(pos mgl64.Vec3, p world.Particle) {
 	switch pa := p.(type) {
@@ -347,17 +391,35 @@ func (s *Session) ViewParticle(pos mgl64.Vec3, p world.Particle) {
 			Position:  vec64To32(pos),
 			EventData: int32(s.blockRuntimeID(pa.Block)),
 		})
+	case particle.HugeExplosion:
+		s.writePacket(&packet.LevelEvent{
+			EventType: packet.EventAddParticleMask | particleHugeExplosionSeed,
+			Position:  vec64To32(pos),
+		})
 	case particle.PunchBlock:
 		s.writePacket(&packet.LevelEvent{
 			EventType: packet.EventParticlePunchBlock,
 			Position:  vec64To32(pos),
 			EventData: int32(s.blockRuntimeID(pa.Block)) | (int32(pa.Face) << 24),
 		})
+	case particle.BoneMeal:
+		s.writePacket(&packet.LevelEvent{
+			EventType: eventParticleCropGrowth,
+			Position:  vec64To32(pos),
+		})
 	}
 }
 
 // ViewSound ...
 func (s *Session) ViewSound(pos mgl64.Vec3, soundType world.Sound) {
+	switch soundType.(type) {
+	case sound.DoorOpen, sound.DoorClose:
+		s.writePacket(&packet.LevelEvent{EventType: packet.EventSoundDoor, Position: vec64To32(pos)})
+		return
+	case sound.AnvilLand:
+		s.writePacket(&packet.LevelEvent{EventType: packet.EventSoundAnvilFall, Position: vec64To32(pos)})
+		return
+	}
 	pk := &packet.LevelSoundEvent{
 		Position:   vec64To32(pos),
 		EntityType: ":",
@@ -383,6 +445,26 @@ func (s *Session) ViewSound(pos mgl64.Vec3, soundType world.Sound) {
 		if !so.Damage {
 			pk.SoundType = packet.SoundEventAttackNoDamage
 		}
+	case sound.Teleport:
+		pk.SoundType = packet.SoundEventTeleport
+	case sound.Thunder:
+		pk.SoundType, pk.EntityType = packet.SoundEventThunder, "minecraft:lightning_bolt"
+	case sound.Explosion:
+		pk.SoundType = packet.SoundEventExplode
+	case sound.Ignite:
+		pk.SoundType = packet.SoundEventIgnite
+	case sound.TNT:
+		pk.SoundType = packet.SoundEventFuse
+	case sound.PowerOn:
+		pk.SoundType = packet.SoundEventPowerOn
+	case sound.PowerOff:
+		pk.SoundType = packet.SoundEventPowerOff
+	case sound.ItemThrow:
+		pk.SoundType, pk.EntityType = packet.SoundEventThrow, "minecraft:player"
+	case sound.BowShoot:
+		pk.SoundType = packet.SoundEventBow
+	case sound.ArrowHit:
+		pk.SoundType = packet.SoundEventBowHit
 	case sound.BucketFill:
 		if _, water := so.Liquid.(block.Water); water {
 			pk.SoundType = packet.SoundEventBucketFillWater
@@ -435,6 +517,11 @@ func (s *Session) ViewEntityAction(e world.Entity, a action.Action) {
 			EntityRuntimeID: s.entityRuntimeID(e),
 			EventType:       packet.ActorEventStartAttack,
 		})
+	case action.WakeUp:
+		s.writePacket(&packet.Animate{
+			ActionType:      packet.AnimateActionStopSleep,
+			EntityRuntimeID: s.entityRuntimeID(e),
+		})
 	case action.Hurt:
 		s.writePacket(&packet.ActorEvent{
 			EntityRuntimeID: s.entityRuntimeID(e),
@@ -464,12 +551,23 @@ func (s *Session) ViewEntityState(e world.Entity, states []state.State) {
 			m.setFlag(dataKeyFlags, dataFlagSprinting)
 		case state.Breathing:
 			m.setFlag(dataKeyFlags, dataFlagBreathing)
+		case state.AirSupply:
+			m[dataKeyAir] = int16(st.Remaining.Milliseconds() / 50)
+			m[dataKeyMaxAir] = int16(st.Max.Milliseconds() / 50)
 		case state.Invisible:
 			m.setFlag(dataKeyFlags, dataFlagInvisible)
 		case state.Swimming:
 			m.setFlag(dataKeyFlags, dataFlagSwimming)
+		case state.OnFire:
+			m.setFlag(dataKeyFlags, dataFlagOnFire)
+		case state.Ignited:
+			m.setFlag(dataKeyFlags, dataFlagIgnited)
+			m[dataKeyFuseLength] = int32(st.Fuse.Milliseconds() / 50)
 		case state.Named:
 			m[dataKeyNameTag] = st.NameTag
+		case state.Sleeping:
+			m[dataKeyPlayerFlags] = byte(1 << dataPlayerFlagSleep)
+			m[dataKeyPlayerBedPosition] = protocol.BlockPos{int32(st.BedPosition[0]), int32(st.BedPosition[1]), int32(st.BedPosition[2])}
 		case state.EffectBearing:
 			m[dataKeyPotionColour] = (int32(st.ParticleColour.A) << 24) | (int32(st.ParticleColour.R) << 16) | (int32(st.ParticleColour.G) << 8) | int32(st.ParticleColour.B)
 			if st.Ambient {
@@ -489,13 +587,15 @@ func (s *Session) ViewEntityState(e world.Entity, states []state.State) {
 func (s *Session) OpenBlockContainer(pos world.BlockPos) {
 	s.closeCurrentContainer()
 
+	if _, craftingTable := s.c.World().Block(pos).(block.CraftingTable); craftingTable {
+		s.openCraftingTable(pos)
+		return
+	}
 	b, ok := s.c.World().Block(pos).(block.Container)
 	if !ok {
 		// The block was no container.
 		return
 	}
-	b.AddViewer(s, s.c.World(), pos)
-
 	nextID := s.nextWindowID()
 	s.containerOpened.Store(true)
 	s.openedWindow.Store(b.Inventory())
@@ -503,6 +603,12 @@ func (s *Session) OpenBlockContainer(pos world.BlockPos) {
 
 	var containerType byte
 	switch b.(type) {
+	case block.Furnace:
+		containerType = containerTypeFurnace
+	case block.BlastFurnace:
+		containerType = containerTypeBlastFurnace
+	case block.Smoker:
+		containerType = containerTypeSmoker
 	}
 
 	s.writePacket(&packet.ContainerOpen{
@@ -512,6 +618,45 @@ func (s *Session) OpenBlockContainer(pos world.BlockPos) {
 		ContainerEntityUniqueID: -1,
 	})
 	s.sendInv(b.Inventory(), uint32(nextID))
+
+	// The viewer is only added once the container is opened client-side, so that any updates sent
+	// immediately, such as the progress of a furnace, are not dropped.
+	b.AddViewer(s, s.c.World(), pos)
+}
+
+// collectExperience collects the experience stored in the smelter currently opened by the session, if any,
+// and adds it to the controllable of the session.
+func (s *Session) collectExperience() {
+	if !s.containerOpened.Load() {
+		return
+	}
+	if smelter, ok := s.c.World().Block(s.openedPos.Load().(world.BlockPos)).(block.Smelter); ok {
+		s.c.AddExperience(smelter.CollectExperience())
+	}
+}
+
+// OpenSign ...
+func (s *Session) OpenSign(pos world.BlockPos) {
+	// The client opens the sign editor by itself after placing a sign, so we only need to make sure that the
+	// text it sends back for the sign at this position is accepted.
+	s.openedSign.Store(pos)
+	s.signOpened.Store(true)
+}
+
+// openCraftingTable opens the crafting table at the position passed. The crafting grid of the table is part
+// of the UI inventory, so no inventory is sent to the client.
+func (s *Session) openCraftingTable(pos world.BlockPos) {
+	nextID := s.nextWindowID()
+	s.containerOpened.Store(true)
+	s.openedWindow.Store(inventory.New(1, nil))
+	s.openedPos.Store(pos)
+
+	s.writePacket(&packet.ContainerOpen{
+		WindowID:                nextID,
+		ContainerType:           containerTypeWorkbench,
+		ContainerPosition:       protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])},
+		ContainerEntityUniqueID: -1,
+	})
 }
 
 // ViewSlotChange ...
@@ -530,6 +675,29 @@ func (s *Session) ViewSlotChange(slot int, newItem item.Stack) {
 	})
 }
 
+// ViewFurnaceProgress ...
+func (s *Session) ViewFurnaceProgress(cook, remainingFuel, maxFuel time.Duration) {
+	if !s.containerOpened.Load() {
+		return
+	}
+	windowID := byte(s.openedWindowID.Load())
+	s.writePacket(&packet.ContainerSetData{
+		WindowID: windowID,
+		Key:      packet.ContainerDataFurnaceTickCount,
+		Value:    int32(cook / (time.Second / 20)),
+	})
+	s.writePacket(&packet.ContainerSetData{
+		WindowID: windowID,
+		Key:      packet.ContainerDataFurnaceLitTime,
+		Value:    int32(remainingFuel / (time.Second / 20)),
+	})
+	s.writePacket(&packet.ContainerSetData{
+		WindowID: windowID,
+		Key:      packet.ContainerDataFurnaceLitDuration,
+		Value:    int32(maxFuel / (time.Second / 20)),
+	})
+}
+
 // ViewBlockAction ...
 func (s *Session) ViewBlockAction(pos world.BlockPos, a blockAction.Action) {
 	blockPos := protocol.BlockPos{int32(pos[0]), int32(pos[1]), int32(pos[2])}
@@ -41,6 +41,23 @@ type ScheduledTicker interface {
 	ScheduledTick(pos BlockPos, w *World)
 }
 
+// TickerBlock represents a block that is ticked every tick, such as a furnace. Only blocks that hold NBT data
+// are ticked this way, as the world keeps track of them as block entities.
+type TickerBlock interface {
+	NBTer
+	// Tick ticks the block at the position passed with the current tick of the world.
+	Tick(currentTick int64, pos BlockPos, w *World)
+}
+
+// TextHolder represents a block that holds text which may be edited, such as a sign.
+type TextHolder interface {
+	Block
+	// HeldText returns the text held by the block. Lines of the text are separated by a newline.
+	HeldText() string
+	// WithText returns the block with its text changed to the text passed.
+	WithText(text string) Block
+}
+
 // NeighbourUpdateTicker represents a block that is updated when a block adjacent to it is updated, either
 // through placement or being broken.
 type NeighbourUpdateTicker interface {
@@ -49,6 +66,19 @@ type NeighbourUpdateTicker interface {
 	NeighbourUpdateTick(pos, changedNeighbour BlockPos, w *World)
 }
 
+// EntityInsider represents a block that reacts to an entity being inside of it, such as a pressure plate.
+type EntityInsider interface {
+	// EntityInside is called every tick for every entity that is inside of the block at the position passed.
+	EntityInside(pos BlockPos, w *World, e Entity)
+}
+
+// EntityLander represents a block that reacts to an entity landing on it after falling, such as farmland.
+type EntityLander interface {
+	// EntityLand is called when the entity passed lands on the block at the position passed after falling
+	// the distance passed.
+	EntityLand(pos BlockPos, w *World, e Entity, distance float64)
+}
+
 // lightEmitter is identical to a block.lightEmitter.
 type lightEmitter interface {
 	LightEmissionLevel() uint8
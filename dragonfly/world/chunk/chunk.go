@@ -60,6 +60,17 @@ func (chunk *Chunk) RuntimeID(x, y, z uint8, layer uint8) uint32 {
 // fullSkyLight is used to copy full light to newly created sub chunks.
 var fullSkyLight [2048]byte
 
+// HighestBlock returns the y value of the highest non-air block at a specific column in the chunk. If the
+// column is made up of only air blocks, 0 is returned.
+func (chunk *Chunk) HighestBlock(x, z uint8) uint8 {
+	for y := 255; y >= 0; y-- {
+		if chunk.RuntimeID(x, uint8(y), z, 0) != 0 {
+			return uint8(y)
+		}
+	}
+	return 0
+}
+
 // SetRuntimeID sets the runtime ID of a block at a given x, y and z in a chunk at the given layer. If no
 // SubChunk exists at the given y, a new SubChunk is created and the block is set.
 func (chunk *Chunk) SetRuntimeID(x, y, z uint8, layer uint8, runtimeID uint32) {
@@ -1,6 +1,7 @@
 package world
 
 import (
+	"fmt"
 	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
 	"github.com/df-mc/dragonfly/dragonfly/entity/state"
 	"github.com/go-gl/mathgl/mgl64"
@@ -41,3 +42,36 @@ type TickerEntity interface {
 	// Tick ticks the entity with the current tick passed.
 	Tick(current int64)
 }
+
+// SaveableEntity represents an Entity that may be saved to a world Provider and loaded from it again. Entities
+// that do not implement SaveableEntity, such as players, are never saved with the chunk they are in.
+type SaveableEntity interface {
+	Entity
+	NBTer
+	// EncodeEntity returns the save ID of the entity, such as 'minecraft:item'. The save ID is written
+	// together with the NBT of the entity so that it may be decoded using the entity registered with
+	// RegisterEntity.
+	EncodeEntity() string
+}
+
+// entities holds all entities registered using RegisterEntity, indexed by their save ID.
+var entities = map[string]SaveableEntity{}
+
+// RegisterEntity registers an entity so that it may be saved to and loaded from a world Provider. The entity
+// is registered using the save ID returned by its EncodeEntity method. When an entity with that save ID is
+// loaded, the DecodeNBT method of the entity registered is called to decode it.
+// If an entity with the same save ID was already registered, RegisterEntity panics.
+func RegisterEntity(e SaveableEntity) {
+	name := e.EncodeEntity()
+	if _, ok := entities[name]; ok {
+		panic(fmt.Sprintf("entity registered with save ID %v already exists", name))
+	}
+	entities[name] = e
+}
+
+// EntityByName looks up an entity registered using RegisterEntity by its save ID. If found, the entity is
+// returned and the bool is true.
+func EntityByName(name string) (SaveableEntity, bool) {
+	e, ok := entities[name]
+	return e, ok
+}
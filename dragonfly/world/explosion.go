@@ -0,0 +1,40 @@
+package world
+
+import (
+	"github.com/go-gl/mathgl/mgl64"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// ExplosionConfig holds the optional settings of an explosion created using World.Explode. The zero value of
+// ExplosionConfig results in an explosion like that of TNT.
+type ExplosionConfig struct {
+	// Source is the entity that caused the explosion, such as primed TNT. Source may be nil.
+	Source Entity
+	// ItemDropChance is the chance, from 0 to 1, that a block destroyed by the explosion drops its items. If
+	// left 0, the vanilla chance of 1/power is used. If negative, no blocks drop items.
+	ItemDropChance float64
+	// SpawnFire specifies if the explosion sets fire to blocks around it.
+	SpawnFire bool
+	// KeepBlocks specifies if the explosion leaves all blocks intact, only damaging the entities around it.
+	KeepBlocks bool
+}
+
+// Explode creates an explosion at the position passed with the power passed. Rays are cast from the position
+// in all directions, each destroying blocks until the blast resistance of the blocks it passes through has
+// used up its strength. Entities close to the explosion are damaged and knocked back depending on their
+// distance to the explosion and the part of their body exposed to it.
+// Explode calls Handler.HandleExplosion, which may cancel the explosion or change the blocks and entities
+// affected by it.
+func (w *World) Explode(pos mgl64.Vec3, power float64, config ExplosionConfig) {
+	if power <= 0 {
+		return
+	}
+	entity_explode(w, pos, power, config)
+}
+
+// The following functions use the go:linkname directive in order to create explosions without the world
+// package having to import the entity package.
+
+//go:linkname entity_explode github.com/df-mc/dragonfly/dragonfly/entity.explode
+//noinspection ALL
+func entity_explode(w *World, pos mgl64.Vec3, power float64, config ExplosionConfig)
@@ -0,0 +1,66 @@
+package world
+
+import (
+	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
+	"reflect"
+)
+
+// SetGameRule sets the game rule passed to a new value. The value must be of the same type as the default
+// value of the game rule, which is either a bool or an int. SetGameRule panics if this is not the case.
+// The new value of the game rule is shown to all viewers of the world.
+func (w *World) SetGameRule(rule gamerule.GameRule, value interface{}) {
+	w.setGameRule(rule, value)
+	for _, viewer := range w.allViewers() {
+		viewer.ViewGameRule(rule, value)
+	}
+}
+
+// GameRule returns the current value of the game rule passed. The value returned is of the same type as the
+// default value of the game rule. If the game rule was never changed, its default value is returned.
+func (w *World) GameRule(rule gamerule.GameRule) interface{} {
+	switch rule.(type) {
+	case gamerule.DoDaylightCycle:
+		return !w.timeStopped.Load()
+	case gamerule.RandomTickSpeed:
+		return int(w.randomTickSpeed.Load())
+	}
+	w.gameRuleMu.RLock()
+	defer w.gameRuleMu.RUnlock()
+	if value, ok := w.gameRules[rule]; ok {
+		return value
+	}
+	return rule.Default()
+}
+
+// GameRules returns all game rules of the world, mapped to their current values.
+func (w *World) GameRules() map[gamerule.GameRule]interface{} {
+	rules := make(map[gamerule.GameRule]interface{})
+	for _, rule := range gamerule.All() {
+		rules[rule] = w.GameRule(rule)
+	}
+	return rules
+}
+
+// setGameRule sets the game rule passed to a new value without showing it to viewers. Game rules that have
+// their own state in the world, such as gamerule.RandomTickSpeed, are applied directly.
+func (w *World) setGameRule(rule gamerule.GameRule, value interface{}) {
+	if reflect.TypeOf(value) != reflect.TypeOf(rule.Default()) {
+		panic(fmt.Sprintf("cannot set game rule %v to %v: value must be of type %T", rule.Name(), value, rule.Default()))
+	}
+	switch rule.(type) {
+	case gamerule.DoDaylightCycle:
+		w.timeStopped.Store(!value.(bool))
+		return
+	case gamerule.RandomTickSpeed:
+		speed := value.(int)
+		if speed < 0 {
+			speed = 0
+		}
+		w.randomTickSpeed.Store(uint32(speed))
+		return
+	}
+	w.gameRuleMu.Lock()
+	w.gameRules[rule] = value
+	w.gameRuleMu.Unlock()
+}
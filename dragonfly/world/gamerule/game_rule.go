@@ -0,0 +1,273 @@
+package gamerule
+
+// GameRule represents a game rule of a world. Game rules change the behaviour of a world and the gameplay in
+// it, such as whether players keep their inventory when they die. The value of a game rule is either a bool
+// or an int, depending on the game rule.
+type GameRule interface {
+	// Name returns the name of the game rule, such as 'keepinventory'. The name is used to save the game rule
+	// and to send it to clients.
+	Name() string
+	// Default returns the default value of the game rule. The value returned is either a bool or an int. Values
+	// set for the game rule must be of the same type.
+	Default() interface{}
+}
+
+// All returns all game rules that exist.
+func All() []GameRule {
+	return []GameRule{
+		CommandBlockOutput{}, CommandBlocksEnabled{}, DoDaylightCycle{}, DoEntityDrops{}, DoFireTick{},
+		DoImmediateRespawn{}, DoInsomnia{}, DoMobLoot{}, DoMobSpawning{}, DoTileDrops{}, DoWeatherCycle{},
+		DrowningDamage{}, FallDamage{}, FireDamage{}, FunctionCommandLimit{}, KeepInventory{},
+		MaxCommandChainLength{}, MobGriefing{}, NaturalRegeneration{}, PVP{}, RandomTickSpeed{},
+		SendCommandFeedback{}, ShowCoordinates{}, ShowDeathMessages{}, SpawnRadius{}, TNTExplodes{},
+	}
+}
+
+// CommandBlockOutput specifies if command blocks broadcast the output of the commands that they execute. The
+// default value is true.
+type CommandBlockOutput struct{}
+
+// CommandBlocksEnabled specifies if command blocks are able to execute commands. The default value is true.
+type CommandBlocksEnabled struct{}
+
+// DoDaylightCycle specifies if the time of the world advances, cycling between day and night. The default
+// value is true.
+type DoDaylightCycle struct{}
+
+// DoEntityDrops specifies if non-living entities, such as minecarts, drop items when they are destroyed. The
+// default value is true.
+type DoEntityDrops struct{}
+
+// DoFireTick specifies if fire burns out and spreads over time. The default value is true.
+type DoFireTick struct{}
+
+// DoImmediateRespawn specifies if players respawn immediately after dying, without the death screen being
+// shown. The default value is false.
+type DoImmediateRespawn struct{}
+
+// DoInsomnia specifies if phantoms spawn around players that have not slept in a while. The default value is
+// true.
+type DoInsomnia struct{}
+
+// DoMobLoot specifies if mobs drop items when they are killed. The default value is true.
+type DoMobLoot struct{}
+
+// DoMobSpawning specifies if mobs spawn naturally in the world. The default value is true.
+type DoMobSpawning struct{}
+
+// DoTileDrops specifies if blocks drop items when they are broken. The default value is true.
+type DoTileDrops struct{}
+
+// DoWeatherCycle specifies if the weather of the world changes over time. The default value is true.
+type DoWeatherCycle struct{}
+
+// DrowningDamage specifies if entities take damage when they run out of air under water. The default value is
+// true.
+type DrowningDamage struct{}
+
+// FallDamage specifies if entities take damage when they fall from a height. The default value is true.
+type FallDamage struct{}
+
+// FireDamage specifies if entities take damage from fire and lava. The default value is true.
+type FireDamage struct{}
+
+// FunctionCommandLimit is the maximum amount of commands that a single function may execute. The default
+// value is 10000.
+type FunctionCommandLimit struct{}
+
+// KeepInventory specifies if players keep the items in their inventory when they die. The default value is
+// false.
+type KeepInventory struct{}
+
+// MaxCommandChainLength is the maximum amount of command blocks that may be chained together. The default
+// value is 65535.
+type MaxCommandChainLength struct{}
+
+// MobGriefing specifies if mobs are able to change blocks in the world, for example by exploding. The default
+// value is true.
+type MobGriefing struct{}
+
+// NaturalRegeneration specifies if players regenerate health when their food bar is sufficiently filled. The
+// default value is true.
+type NaturalRegeneration struct{}
+
+// PVP specifies if players are able to attack other players. The default value is true.
+type PVP struct{}
+
+// RandomTickSpeed is the amount of blocks in each sub chunk that receive a random tick every tick. The
+// default value is 3.
+type RandomTickSpeed struct{}
+
+// SendCommandFeedback specifies if the output of commands is sent to the player that executed them. The
+// default value is true.
+type SendCommandFeedback struct{}
+
+// ShowCoordinates specifies if the coordinates of players are shown on their screen. The default value is
+// false.
+type ShowCoordinates struct{}
+
+// ShowDeathMessages specifies if a message is broadcast in the chat when a player dies. The default value is
+// true.
+type ShowDeathMessages struct{}
+
+// SpawnRadius is the radius around the world spawn in which new players spawn. The default value is 5.
+type SpawnRadius struct{}
+
+// TNTExplodes specifies if TNT explodes when it is ignited. The default value is true.
+type TNTExplodes struct{}
+
+// Name ...
+func (CommandBlockOutput) Name() string { return "commandblockoutput" }
+
+// Default ...
+func (CommandBlockOutput) Default() interface{} { return true }
+
+// Name ...
+func (CommandBlocksEnabled) Name() string { return "commandblocksenabled" }
+
+// Default ...
+func (CommandBlocksEnabled) Default() interface{} { return true }
+
+// Name ...
+func (DoDaylightCycle) Name() string { return "dodaylightcycle" }
+
+// Default ...
+func (DoDaylightCycle) Default() interface{} { return true }
+
+// Name ...
+func (DoEntityDrops) Name() string { return "doentitydrops" }
+
+// Default ...
+func (DoEntityDrops) Default() interface{} { return true }
+
+// Name ...
+func (DoFireTick) Name() string { return "dofiretick" }
+
+// Default ...
+func (DoFireTick) Default() interface{} { return true }
+
+// Name ...
+func (DoImmediateRespawn) Name() string { return "doimmediaterespawn" }
+
+// Default ...
+func (DoImmediateRespawn) Default() interface{} { return false }
+
+// Name ...
+func (DoInsomnia) Name() string { return "doinsomnia" }
+
+// Default ...
+func (DoInsomnia) Default() interface{} { return true }
+
+// Name ...
+func (DoMobLoot) Name() string { return "domobloot" }
+
+// Default ...
+func (DoMobLoot) Default() interface{} { return true }
+
+// Name ...
+func (DoMobSpawning) Name() string { return "domobspawning" }
+
+// Default ...
+func (DoMobSpawning) Default() interface{} { return true }
+
+// Name ...
+func (DoTileDrops) Name() string { return "dotiledrops" }
+
+// Default ...
+func (DoTileDrops) Default() interface{} { return true }
+
+// Name ...
+func (DoWeatherCycle) Name() string { return "doweathercycle" }
+
+// Default ...
+func (DoWeatherCycle) Default() interface{} { return true }
+
+// Name ...
+func (DrowningDamage) Name() string { return "drowningdamage" }
+
+// Default ...
+func (DrowningDamage) Default() interface{} { return true }
+
+// Name ...
+func (FallDamage) Name() string { return "falldamage" }
+
+// Default ...
+func (FallDamage) Default() interface{} { return true }
+
+// Name ...
+func (FireDamage) Name() string { return "firedamage" }
+
+// Default ...
+func (FireDamage) Default() interface{} { return true }
+
+// Name ...
+func (FunctionCommandLimit) Name() string { return "functioncommandlimit" }
+
+// Default ...
+func (FunctionCommandLimit) Default() interface{} { return 10000 }
+
+// Name ...
+func (KeepInventory) Name() string { return "keepinventory" }
+
+// Default ...
+func (KeepInventory) Default() interface{} { return false }
+
+// Name ...
+func (MaxCommandChainLength) Name() string { return "maxcommandchainlength" }
+
+// Default ...
+func (MaxCommandChainLength) Default() interface{} { return 65535 }
+
+// Name ...
+func (MobGriefing) Name() string { return "mobgriefing" }
+
+// Default ...
+func (MobGriefing) Default() interface{} { return true }
+
+// Name ...
+func (NaturalRegeneration) Name() string { return "naturalregeneration" }
+
+// Default ...
+func (NaturalRegeneration) Default() interface{} { return true }
+
+// Name ...
+func (PVP) Name() string { return "pvp" }
+
+// Default ...
+func (PVP) Default() interface{} { return true }
+
+// Name ...
+func (RandomTickSpeed) Name() string { return "randomtickspeed" }
+
+// Default ...
+func (RandomTickSpeed) Default() interface{} { return 3 }
+
+// Name ...
+func (SendCommandFeedback) Name() string { return "sendcommandfeedback" }
+
+// Default ...
+func (SendCommandFeedback) Default() interface{} { return true }
+
+// Name ...
+func (ShowCoordinates) Name() string { return "showcoordinates" }
+
+// Default ...
+func (ShowCoordinates) Default() interface{} { return false }
+
+// Name ...
+func (ShowDeathMessages) Name() string { return "showdeathmessages" }
+
+// Default ...
+func (ShowDeathMessages) Default() interface{} { return true }
+
+// Name ...
+func (SpawnRadius) Name() string { return "spawnradius" }
+
+// Default ...
+func (SpawnRadius) Default() interface{} { return 5 }
+
+// Name ...
+func (TNTExplodes) Name() string { return "tntexplodes" }
+
+// Default ...
+func (TNTExplodes) Default() interface{} { return true }
@@ -0,0 +1,126 @@
+package generator
+
+import (
+	"math"
+	"math/rand"
+)
+
+// noise is a seeded implementation of improved Perlin noise. It produces smooth, continuous values in the
+// range -1 to 1 for any position passed.
+type noise struct {
+	perm [512]uint8
+}
+
+// newNoise creates a new noise source, shuffling its permutation table using the random source passed.
+func newNoise(r *rand.Rand) *noise {
+	n := &noise{}
+	for i := 0; i < 256; i++ {
+		n.perm[i] = uint8(i)
+	}
+	r.Shuffle(256, func(i, j int) {
+		n.perm[i], n.perm[j] = n.perm[j], n.perm[i]
+	})
+	copy(n.perm[256:], n.perm[:256])
+	return n
+}
+
+// sample3D samples the noise at a position in three dimensional space.
+func (n *noise) sample3D(x, y, z float64) float64 {
+	fx, fy, fz := math.Floor(x), math.Floor(y), math.Floor(z)
+	xi, yi, zi := int(fx)&255, int(fy)&255, int(fz)&255
+	x, y, z = x-fx, y-fy, z-fz
+	u, v, w := fade(x), fade(y), fade(z)
+
+	p := n.perm
+	a := int(p[xi]) + yi
+	aa, ab := int(p[a])+zi, int(p[a+1])+zi
+	b := int(p[xi+1]) + yi
+	ba, bb := int(p[b])+zi, int(p[b+1])+zi
+
+	return lerp(w,
+		lerp(v,
+			lerp(u, grad(p[aa], x, y, z), grad(p[ba], x-1, y, z)),
+			lerp(u, grad(p[ab], x, y-1, z), grad(p[bb], x-1, y-1, z))),
+		lerp(v,
+			lerp(u, grad(p[aa+1], x, y, z-1), grad(p[ba+1], x-1, y, z-1)),
+			lerp(u, grad(p[ab+1], x, y-1, z-1), grad(p[bb+1], x-1, y-1, z-1))),
+	)
+}
+
+// sample2D samples the noise at a position in two dimensional space.
+func (n *noise) sample2D(x, z float64) float64 {
+	// Sampling at a y that is not a whole number prevents the noise from being flattened, which happens for
+	// the gradients at whole coordinates.
+	return n.sample3D(x, 0.5, z)
+}
+
+// octaves is a list of noise sources that are sampled at increasing frequencies and decreasing amplitudes
+// and summed. This produces noise with both large features and small details.
+type octaves []*noise
+
+// newOctaves creates a list of count octaves using the random source passed.
+func newOctaves(r *rand.Rand, count int) octaves {
+	o := make(octaves, count)
+	for i := range o {
+		o[i] = newNoise(r)
+	}
+	return o
+}
+
+// sample2D samples the octaves at a position in two dimensional space. The frequency passed is the frequency
+// of the first octave. The value returned is in the range -1 to 1.
+func (o octaves) sample2D(x, z, frequency float64) float64 {
+	var sum, amplitude, total = 0.0, 1.0, 0.0
+	for _, n := range o {
+		sum += n.sample2D(x*frequency, z*frequency) * amplitude
+		total += amplitude
+		amplitude /= 2
+		frequency *= 2
+	}
+	return sum / total
+}
+
+// sample3D samples the octaves at a position in three dimensional space. The frequency passed is the
+// frequency of the first octave. The value returned is in the range -1 to 1.
+func (o octaves) sample3D(x, y, z, frequency float64) float64 {
+	var sum, amplitude, total = 0.0, 1.0, 0.0
+	for _, n := range o {
+		sum += n.sample3D(x*frequency, y*frequency, z*frequency) * amplitude
+		total += amplitude
+		amplitude /= 2
+		frequency *= 2
+	}
+	return sum / total
+}
+
+// fade smooths the value t passed using the fade curve 6t^5 - 15t^4 + 10t^3.
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+// lerp linearly interpolates between a and b using t.
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
+
+// grad computes the dot product of a pseudo-random gradient vector selected by the hash passed and the
+// vector x, y, z.
+func grad(hash uint8, x, y, z float64) float64 {
+	h := hash & 15
+	u, v := y, z
+	if h < 8 {
+		u = x
+	}
+	if h < 4 {
+		v = y
+	} else if h == 12 || h == 14 {
+		v = x
+	}
+	if h&1 != 0 {
+		u = -u
+	}
+	if h&2 != 0 {
+		v = -v
+	}
+	return u + v
+}
@@ -0,0 +1,172 @@
+package generator
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/block"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/chunk"
+	"math"
+	"math/rand"
+)
+
+// Overworld is a generator that generates terrain similar to that of the vanilla overworld. It produces hills,
+// mountains and oceans from multi-octave noise, carves caves into the terrain and assigns biomes to the
+// columns of each chunk.
+// Overworld generates the same terrain for the same seed.
+type Overworld struct {
+	seed int64
+
+	continent, detail, mountains octaves
+	temperature, rainfall        octaves
+	caveA, caveB                 octaves
+}
+
+// SeaLevel is the height up to which oceans generated by the Overworld generator are filled with water.
+const SeaLevel = 62
+
+var (
+	stone, _      = world.BlockRuntimeID(block.Stone{})
+	water, _      = world.BlockRuntimeID(block.Water{Still: true, Depth: 8})
+	lava, _       = world.BlockRuntimeID(block.Lava{Still: true, Depth: 8})
+	coarseDirt, _ = world.BlockRuntimeID(block.Dirt{Coarse: true})
+)
+
+// Biome IDs of the biomes that may be generated by the Overworld generator.
+const (
+	biomeOcean        = 0
+	biomePlains       = 1
+	biomeExtremeHills = 3
+	biomeForest       = 4
+	biomeTaiga        = 5
+	biomeSwampland    = 6
+	biomeIcePlains    = 12
+	biomeBeach        = 16
+	biomeJungle       = 21
+	biomeDeepOcean    = 24
+	biomeSavanna      = 35
+)
+
+// NewOverworld creates a new Overworld generator that generates terrain using the seed passed.
+func NewOverworld(seed int64) Overworld {
+	r := rand.New(rand.NewSource(seed))
+	return Overworld{
+		seed:        seed,
+		continent:   newOctaves(r, 4),
+		detail:      newOctaves(r, 4),
+		mountains:   newOctaves(r, 3),
+		temperature: newOctaves(r, 2),
+		rainfall:    newOctaves(r, 2),
+		caveA:       newOctaves(r, 2),
+		caveB:       newOctaves(r, 2),
+	}
+}
+
+// Seed returns the seed that the Overworld generator generates terrain with.
+func (o Overworld) Seed() int64 {
+	return o.seed
+}
+
+// GenerateChunk ...
+func (o Overworld) GenerateChunk(pos world.ChunkPos, c *chunk.Chunk) {
+	for x := uint8(0); x < 16; x++ {
+		for z := uint8(0); z < 16; z++ {
+			wx, wz := float64(pos.X()<<4+int32(x)), float64(pos.Z()<<4+int32(z))
+
+			height := o.height(wx, wz)
+			biome := o.biome(wx, wz, height)
+			c.SetBiomeID(x, z, biome)
+
+			o.fillColumn(c, x, z, height, biome)
+			if height > SeaLevel {
+				// Only carve caves in columns above sea level, so that oceans do not leak into caves.
+				o.carveCaves(c, x, z, wx, wz, height)
+			}
+		}
+	}
+}
+
+// fillColumn fills a single column of the chunk passed with bedrock, stone, dirt, grass and water up to the
+// height passed.
+func (o Overworld) fillColumn(c *chunk.Chunk, x, z uint8, height int, biome uint8) {
+	c.SetRuntimeID(x, 0, z, 0, bedrock)
+	for y := 1; y <= height; y++ {
+		rid := stone
+		switch {
+		case y == height && height >= SeaLevel:
+			rid = grass
+			if biome == biomeSavanna && height > 90 {
+				rid = coarseDirt
+			}
+		case y > height-4:
+			rid = dirt
+		}
+		c.SetRuntimeID(x, uint8(y), z, 0, rid)
+	}
+	for y := height + 1; y <= SeaLevel; y++ {
+		c.SetRuntimeID(x, uint8(y), z, 0, water)
+	}
+}
+
+// carveCaves carves caves into a column of the chunk passed. Caves are carved where two 3D noise fields are
+// both close to zero, which produces long, winding tunnels. Caves below y=11 are filled with lava.
+func (o Overworld) carveCaves(c *chunk.Chunk, x, z uint8, wx, wz float64, height int) {
+	for y := 1; y < height; y++ {
+		wy := float64(y)
+		a := o.caveA.sample3D(wx, wy*1.5, wz, 1.0/48)
+		b := o.caveB.sample3D(wx, wy*1.5, wz, 1.0/48)
+		if a*a+b*b > 0.006 {
+			continue
+		}
+		if y < 11 {
+			c.SetRuntimeID(x, uint8(y), z, 0, lava)
+			continue
+		}
+		c.SetRuntimeID(x, uint8(y), z, 0, 0)
+	}
+}
+
+// height returns the height of the terrain at the x and z passed.
+func (o Overworld) height(x, z float64) int {
+	continent := o.continent.sample2D(x, z, 1.0/512)
+	detail := o.detail.sample2D(x, z, 1.0/64)
+
+	h := SeaLevel + 2 + continent*48 + detail*8
+	if mountains := o.mountains.sample2D(x, z, 1.0/256); mountains > 0.1 && continent > 0 {
+		// Mountainous terrain only generates inland, and rises more steeply the further from the coast.
+		h += (mountains - 0.1) * continent * 220
+	}
+	return int(math.Max(4, math.Min(h, 250)))
+}
+
+// biome returns the biome at the x and z passed, based on the height of the terrain at that position and the
+// temperature and rainfall of the position.
+func (o Overworld) biome(x, z float64, height int) uint8 {
+	switch {
+	case height < SeaLevel-18:
+		return biomeDeepOcean
+	case height < SeaLevel-3:
+		return biomeOcean
+	case height <= SeaLevel+1:
+		return biomeBeach
+	case height > 100:
+		return biomeExtremeHills
+	}
+	temperature := o.temperature.sample2D(x, z, 1.0/1024)
+	rainfall := o.rainfall.sample2D(x, z, 1.0/1024)
+	switch {
+	case temperature < -0.3:
+		if rainfall > 0 {
+			return biomeTaiga
+		}
+		return biomeIcePlains
+	case temperature > 0.3:
+		if rainfall > 0.2 {
+			return biomeJungle
+		}
+		return biomeSavanna
+	case rainfall > 0.3 && height < SeaLevel+6:
+		return biomeSwampland
+	case rainfall > 0:
+		return biomeForest
+	}
+	return biomePlains
+}
@@ -1,6 +1,9 @@
 package world
 
-import "github.com/df-mc/dragonfly/dragonfly/event"
+import (
+	"github.com/df-mc/dragonfly/dragonfly/event"
+	"github.com/go-gl/mathgl/mgl64"
+)
 
 // Handler handles events that are called by a world. Implementations of Handler may be used to listen to
 // specific events such as when an entity is added to the world.
@@ -12,6 +15,32 @@ type Handler interface {
 	// liquidHardened, and the liquid that caused it to harden, otherLiquid, are passed. The block created
 	// as a result is also passed.
 	HandleLiquidHarden(ctx *event.Context, hardenedPos BlockPos, liquidHardened, otherLiquid, newBlock Block)
+	// HandleProjectileHitBlock handles a projectile, such as an arrow or a snowball, hitting the face of a
+	// block at the position passed. ctx.Cancel() may be called to cancel the effects of the hit, such as an
+	// ender pearl teleporting its owner. The projectile stops moving regardless.
+	HandleProjectileHitBlock(ctx *event.Context, projectile Entity, pos BlockPos, face Face)
+	// HandleProjectileHitEntity handles a projectile hitting an entity. ctx.Cancel() may be called to cancel
+	// the effects of the hit, such as the damage dealt to the entity.
+	HandleProjectileHitEntity(ctx *event.Context, projectile, e Entity)
+	// HandleExplosion handles an explosion at the position passed. The entities and the positions of the blocks
+	// affected by the explosion are passed and may be changed, as may the chance for the blocks destroyed to
+	// drop their items and whether the explosion spawns fire. ctx.Cancel() may be called to cancel the
+	// explosion altogether.
+	HandleExplosion(ctx *event.Context, position mgl64.Vec3, entities *[]Entity, blocks *[]BlockPos, itemDropChance *float64, spawnFire *bool)
+	// HandleRainChange handles the rain starting or stopping in the world. raining is true if the rain
+	// starts and false if it stops. ctx.Cancel() may be called to keep the current weather.
+	HandleRainChange(ctx *event.Context, raining bool)
+	// HandleThunderChange handles the thunder starting or stopping in the world. thundering is true if the
+	// thunder starts and false if it stops. ctx.Cancel() may be called to keep the current weather.
+	HandleThunderChange(ctx *event.Context, thundering bool)
+	// HandleCropGrowth handles a crop growing at the position passed, such as wheat growing a stage or a melon
+	// stem growing a melon next to it. The block currently at the position and the block that it will be
+	// replaced with are passed. ctx.Cancel() may be called to stop the crop from growing.
+	HandleCropGrowth(ctx *event.Context, pos BlockPos, crop, newCrop Block)
+	// HandleFarmlandTrample handles an entity trampling the farmland at the position passed by landing on it,
+	// which turns the farmland into dirt. ctx.Cancel() may be called to prevent the farmland from being
+	// trampled.
+	HandleFarmlandTrample(ctx *event.Context, pos BlockPos, e Entity)
 }
 
 // NopHandler implements the Handler interface but does not execute any code when an event is called. The
@@ -27,3 +56,24 @@ func (NopHandler) HandleLiquidFlow(*event.Context, BlockPos, BlockPos, Block, Bl
 
 // HandleLiquidHarden ...
 func (NopHandler) HandleLiquidHarden(*event.Context, BlockPos, Block, Block, Block) {}
+
+// HandleProjectileHitBlock ...
+func (NopHandler) HandleProjectileHitBlock(*event.Context, Entity, BlockPos, Face) {}
+
+// HandleProjectileHitEntity ...
+func (NopHandler) HandleProjectileHitEntity(*event.Context, Entity, Entity) {}
+
+// HandleExplosion ...
+func (NopHandler) HandleExplosion(*event.Context, mgl64.Vec3, *[]Entity, *[]BlockPos, *float64, *bool) {}
+
+// HandleRainChange ...
+func (NopHandler) HandleRainChange(*event.Context, bool) {}
+
+// HandleThunderChange ...
+func (NopHandler) HandleThunderChange(*event.Context, bool) {}
+
+// HandleCropGrowth ...
+func (NopHandler) HandleCropGrowth(*event.Context, BlockPos, Block, Block) {}
+
+// HandleFarmlandTrample ...
+func (NopHandler) HandleFarmlandTrample(*event.Context, BlockPos, Entity) {}
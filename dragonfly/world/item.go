@@ -69,3 +69,14 @@ func itemToName(it Item) (name string, meta int16) {
 	id, meta := it.EncodeItem()
 	return names[id], meta
 }
+
+// itemNames returns the names of all registered items, such as 'minecraft:stone'.
+//lint:ignore U1000 Function is used using compiler directives.
+//noinspection GoUnusedFunction
+func itemNames() []string {
+	n := make([]string, 0, len(itemsNames))
+	for name := range itemsNames {
+		n = append(n, name)
+	}
+	return n
+}
@@ -40,6 +40,28 @@ func (l *Loader) ChangeRadius(new int) {
 	l.mu.Unlock()
 }
 
+// World returns the World that the Loader is in.
+func (l *Loader) World() *World {
+	l.mu.RLock()
+	w := l.w
+	l.mu.RUnlock()
+	return w
+}
+
+// ChangeWorld changes the World of the Loader. All chunks currently loaded in the old World are unloaded for
+// the viewer and the chunks around the Loader's position are loaded again from the new World.
+func (l *Loader) ChangeWorld(new *World) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for pos := range l.loaded {
+		l.w.removeViewer(pos, l.viewer)
+	}
+	l.loaded = map[ChunkPos]*chunk.Chunk{}
+	l.w = new
+	l.populateLoadQueue()
+}
+
 // Move moves the loader to the position passed. The position is translated to a chunk position to load
 func (l *Loader) Move(pos mgl64.Vec3) {
 	l.mu.Lock()
@@ -8,6 +8,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/world/chunk"
 	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"github.com/df-mc/goleveldb/leveldb"
 	"github.com/df-mc/goleveldb/leveldb/opt"
 	"github.com/sandertv/gophertunnel/minecraft/nbt"
@@ -15,6 +16,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"reflect"
 	"time"
 )
 
@@ -77,12 +79,11 @@ func (p *Provider) initDefaultLevelDat() {
 	p.d.Abilities.WalkSpeed = 0.1
 	p.d.PVP = true
 	p.d.WorldStartCount = 1
-	p.d.RandomTickSpeed = 1
-	p.d.FallDamage = true
-	p.d.FireDamage = true
-	p.d.DrowningDamage = true
 	p.d.CommandsEnabled = true
 	p.d.MultiPlayerGame = true
+	for _, rule := range gamerule.All() {
+		p.SaveGameRule(rule, rule.Default())
+	}
 }
 
 // LoadTime returns the time as it was stored in the level.dat of the world loaded.
@@ -105,6 +106,75 @@ func (p *Provider) SaveTimeCycle(running bool) {
 	p.d.DoDayLightCycle = running
 }
 
+// LoadRaining returns whether it is raining or not and the amount of ticks until that changes, as stored in
+// the level.dat.
+func (p *Provider) LoadRaining() (bool, int) {
+	return p.d.RainLevel > 0, int(p.d.RainTime)
+}
+
+// SaveRaining saves the state of the rain and the amount of ticks until it changes to the level.dat.
+func (p *Provider) SaveRaining(raining bool, ticks int) {
+	p.d.RainLevel, p.d.RainTime = 0, int32(ticks)
+	if raining {
+		p.d.RainLevel = 1
+	}
+}
+
+// LoadThundering returns whether it is thundering or not and the amount of ticks until that changes, as
+// stored in the level.dat.
+func (p *Provider) LoadThundering() (bool, int) {
+	return p.d.LightningLevel > 0, int(p.d.LightningTime)
+}
+
+// SaveThundering saves the state of the thunder and the amount of ticks until it changes to the level.dat.
+func (p *Provider) SaveThundering(thundering bool, ticks int) {
+	p.d.LightningLevel, p.d.LightningTime = 0, int32(ticks)
+	if thundering {
+		p.d.LightningLevel = 1
+	}
+}
+
+// LoadGameRule loads the value of a game rule from the level.dat.
+func (p *Provider) LoadGameRule(rule gamerule.GameRule) (interface{}, bool) {
+	field, ok := p.gameRuleField(rule)
+	if !ok {
+		return nil, false
+	}
+	switch field.Kind() {
+	case reflect.Bool:
+		return field.Bool(), true
+	case reflect.Int32:
+		return int(field.Int()), true
+	}
+	return nil, false
+}
+
+// SaveGameRule saves the value of a game rule to the level.dat.
+func (p *Provider) SaveGameRule(rule gamerule.GameRule, value interface{}) {
+	field, ok := p.gameRuleField(rule)
+	if !ok {
+		return
+	}
+	switch v := value.(type) {
+	case bool:
+		field.SetBool(v)
+	case int:
+		field.SetInt(int64(v))
+	}
+}
+
+// gameRuleField looks up the field in the level.dat data that holds the value of the game rule passed. If no
+// such field exists, false is returned.
+func (p *Provider) gameRuleField(rule gamerule.GameRule) (reflect.Value, bool) {
+	v := reflect.ValueOf(&p.d).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("nbt") == rule.Name() {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
 // WorldName returns the name of the world that the provider provides data for.
 func (p *Provider) WorldName() string {
 	return p.d.LevelName
@@ -258,16 +328,56 @@ func (p *Provider) SaveDifficulty(d difficulty.Difficulty) {
 	}
 }
 
-// LoadEntities loads all entities from the chunk position passed.
-func (p *Provider) LoadEntities(world.ChunkPos) ([]world.Entity, error) {
-	// TODO: Implement entities.
-	return nil, nil
+// LoadEntities loads all entities from the chunk position passed. Entities of which the save ID was not
+// registered using world.RegisterEntity are skipped.
+func (p *Provider) LoadEntities(position world.ChunkPos) ([]world.Entity, error) {
+	data, err := p.db.Get(append(index(position), keyEntities), nil)
+	if err != leveldb.ErrNotFound && err != nil {
+		return nil, err
+	}
+	var a []world.Entity
+
+	buf := bytes.NewBuffer(data)
+	dec := nbt.NewDecoderWithEncoding(buf, nbt.LittleEndian)
+
+	for buf.Len() != 0 {
+		var m map[string]interface{}
+		if err := dec.Decode(&m); err != nil {
+			return nil, fmt.Errorf("error decoding entity NBT: %w", err)
+		}
+		id, _ := m["identifier"].(string)
+		e, ok := world.EntityByName(id)
+		if !ok {
+			// The entity was not registered, so we can't decode it.
+			continue
+		}
+		if v, ok := e.DecodeNBT(m).(world.Entity); ok {
+			a = append(a, v)
+		}
+	}
+	return a, nil
 }
 
-// SaveEntities saves all entities to the chunk position passed.
-func (p *Provider) SaveEntities(world.ChunkPos, []world.Entity) error {
-	// TODO: Implement entities.
-	return nil
+// SaveEntities saves all entities to the chunk position passed. Entities that do not implement
+// world.SaveableEntity are not saved.
+func (p *Provider) SaveEntities(position world.ChunkPos, entities []world.Entity) error {
+	buf := bytes.NewBuffer(nil)
+	enc := nbt.NewEncoderWithEncoding(buf, nbt.LittleEndian)
+	for _, e := range entities {
+		s, ok := e.(world.SaveableEntity)
+		if !ok {
+			continue
+		}
+		m := s.EncodeNBT()
+		m["identifier"] = s.EncodeEntity()
+		if err := enc.Encode(m); err != nil {
+			return fmt.Errorf("error encoding entity NBT: %w", err)
+		}
+	}
+	if buf.Len() == 0 {
+		return p.db.Delete(append(index(position), keyEntities), nil)
+	}
+	return p.db.Put(append(index(position), keyEntities), buf.Bytes(), nil)
 }
 
 // LoadBlockNBT loads all block entities from the chunk position passed.
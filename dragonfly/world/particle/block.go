@@ -28,3 +28,9 @@ func (PunchBlock) Spawn(*world.World, mgl64.Vec3) {}
 
 // Spawn ...
 func (BlockBreak) Spawn(*world.World, mgl64.Vec3) {}
+
+// BoneMeal is a particle shown when bone meal is used on a block, such as a crop.
+type BoneMeal struct{}
+
+// Spawn ...
+func (BoneMeal) Spawn(*world.World, mgl64.Vec3) {}
@@ -0,0 +1,13 @@
+package particle
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/go-gl/mathgl/mgl64"
+)
+
+// HugeExplosion is a particle shown when an explosion happens, such as when TNT explodes. It shows a bunch
+// of large explosion clouds around the position it is spawned at.
+type HugeExplosion struct{}
+
+// Spawn ...
+func (HugeExplosion) Spawn(*world.World, mgl64.Vec3) {}
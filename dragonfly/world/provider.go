@@ -4,6 +4,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/world/chunk"
 	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"io"
 )
 
@@ -52,6 +53,24 @@ type Provider interface {
 	// LoadTimeCycle loads the state of the time cycle: If time is running, true is returned. If the time
 	// cycle is stopped, false is returned.
 	LoadTimeCycle() bool
+	// LoadRaining loads the state of the rain in the world: If it is currently raining, true is returned. The
+	// ticks returned are the amount of ticks until the rain starts or stops. If the amount of ticks is not
+	// known, 0 is returned.
+	LoadRaining() (raining bool, ticks int)
+	// SaveRaining saves the state of the rain in the world and the amount of ticks until it starts or stops.
+	SaveRaining(raining bool, ticks int)
+	// LoadThundering loads the state of the thunder in the world: If it is currently thundering, true is
+	// returned. The ticks returned are the amount of ticks until the thunder starts or stops. If the amount
+	// of ticks is not known, 0 is returned.
+	LoadThundering() (thundering bool, ticks int)
+	// SaveThundering saves the state of the thunder in the world and the amount of ticks until it starts or
+	// stops.
+	SaveThundering(thundering bool, ticks int)
+	// LoadGameRule loads the value of the game rule passed. The value returned is of the same type as the
+	// default value of the game rule. If no value is stored for the game rule, ok is false.
+	LoadGameRule(rule gamerule.GameRule) (value interface{}, ok bool)
+	// SaveGameRule saves the value of the game rule passed.
+	SaveGameRule(rule gamerule.GameRule, value interface{})
 	// LoadDefaultGameMode loads the default game mode of the world.
 	LoadDefaultGameMode() gamemode.GameMode
 	// SaveDefaultGameMode sets the default game mode of the world.
@@ -89,6 +108,30 @@ func (NoIOProvider) LoadTimeCycle() bool {
 	return true
 }
 
+// LoadRaining ...
+func (NoIOProvider) LoadRaining() (bool, int) {
+	return false, 0
+}
+
+// SaveRaining ...
+func (NoIOProvider) SaveRaining(bool, int) {}
+
+// LoadThundering ...
+func (NoIOProvider) LoadThundering() (bool, int) {
+	return false, 0
+}
+
+// SaveThundering ...
+func (NoIOProvider) SaveThundering(bool, int) {}
+
+// LoadGameRule ...
+func (NoIOProvider) LoadGameRule(gamerule.GameRule) (interface{}, bool) {
+	return nil, false
+}
+
+// SaveGameRule ...
+func (NoIOProvider) SaveGameRule(gamerule.GameRule, interface{}) {}
+
 // LoadTime ...
 func (NoIOProvider) LoadTime() int64 {
 	return 0
@@ -0,0 +1,150 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/entity/physics"
+	"github.com/go-gl/mathgl/mgl64"
+	"time"
+)
+
+// RedstoneEmitter represents a block that emits redstone power, such as a lever or a redstone torch.
+type RedstoneEmitter interface {
+	// WeakPower returns the redstone power, from 0-15, that the block at the position passed emits towards
+	// the block on the face passed. Weak power only powers redstone components directly next to the block.
+	WeakPower(pos BlockPos, face Face, w *World) int
+	// StrongPower returns the redstone power, from 0-15, that the block at the position passed emits into the
+	// block on the face passed. A solid block that is strongly powered conducts the power to the redstone
+	// components around it.
+	StrongPower(pos BlockPos, face Face, w *World) int
+}
+
+// RedstoneWire represents a redstone emitter that carries power over a distance, such as redstone dust.
+// Redstone wire receives no power from other wire through World.RedstonePower: Wire takes care of the decay
+// of the power carried between wire by itself.
+type RedstoneWire interface {
+	RedstoneEmitter
+	// WirePower returns the redstone power, from 0-15, currently carried by the wire.
+	WirePower() int
+}
+
+// RedstonePower returns the redstone power, from 0-15, that the block at the position passed receives from
+// the block on the face passed. If that block is a redstone emitter, the power it emits towards the position
+// is returned. If it is a solid block instead, the highest strong power that the block is powered with by
+// the emitters around it is returned.
+// If the block at the position passed is RedstoneWire, power emitted by other wire is not taken into
+// account.
+func (w *World) RedstonePower(pos BlockPos, face Face) int {
+	_, wire := w.Block(pos).(RedstoneWire)
+
+	side := pos.Side(face)
+	b := w.Block(side)
+	if emitter, ok := b.(RedstoneEmitter); ok {
+		if _, ok := b.(RedstoneWire); ok && wire {
+			return 0
+		}
+		return emitter.WeakPower(side, face.Opposite(), w)
+	}
+	if !w.RedstoneConductor(side) {
+		return 0
+	}
+	power := 0
+	for f := FaceDown; f <= FaceEast; f++ {
+		neighbour := side.Side(f)
+		nb := w.Block(neighbour)
+		emitter, ok := nb.(RedstoneEmitter)
+		if !ok {
+			continue
+		}
+		if _, ok := nb.(RedstoneWire); ok && wire {
+			continue
+		}
+		if p := emitter.StrongPower(neighbour, f.Opposite(), w); p > power {
+			power = p
+		}
+	}
+	return power
+}
+
+// ReceivedRedstonePower returns the highest redstone power, from 0-15, that the block at the position passed
+// receives from any of its sides. ReceivedRedstonePower returns the highest value that RedstonePower returns
+// for any face of the block.
+func (w *World) ReceivedRedstonePower(pos BlockPos) int {
+	power := 0
+	for f := FaceDown; f <= FaceEast; f++ {
+		if p := w.RedstonePower(pos, f); p > power {
+			power = p
+		}
+	}
+	return power
+}
+
+// RedstoneConductor checks if the block at the position passed conducts redstone power. Full, solid blocks
+// that are not redstone emitters themselves conduct the power that they are strongly powered with.
+func (w *World) RedstoneConductor(pos BlockPos) bool {
+	b := w.Block(pos)
+	if _, ok := b.(RedstoneEmitter); ok {
+		return false
+	}
+	if diffuser, ok := b.(lightDiffuser); ok && diffuser.LightDiffusionLevel() < 15 {
+		return false
+	}
+	if aabb, ok := b.(interface {
+		AABB(pos BlockPos, w *World) []physics.AABB
+	}); ok {
+		boxes := aabb.AABB(pos, w)
+		return len(boxes) == 1 && boxes[0].Min() == (mgl64.Vec3{}) && boxes[0].Max() == (mgl64.Vec3{1, 1, 1})
+	}
+	return true
+}
+
+// UpdateRedstone updates the blocks around the position passed after the redstone power emitted by the block
+// at that position changed. Both the neighbours of the position and the neighbours of those neighbours are
+// updated, as redstone components may be powered through solid blocks or connect to redstone dust a block
+// higher or lower. The order of the updates is always the same, so that redstone contraptions behave the
+// same every time.
+func (w *World) UpdateRedstone(pos BlockPos) {
+	w.doBlockUpdatesAround(pos)
+	pos.Neighbours(func(neighbour BlockPos) {
+		neighbour.Neighbours(func(n BlockPos) {
+			if n != pos {
+				w.updateNeighbour(n, neighbour)
+			}
+		})
+	})
+}
+
+// ToggleRedstone records that the redstone component at the position passed was toggled. The toggle is
+// remembered for the duration passed, after which it is forgotten again. The amount of toggles of the
+// component currently remembered, including the new one, is returned. Redstone torches use it to burn out if
+// they are toggled too often in a short period of time.
+func (w *World) ToggleRedstone(pos BlockPos, d time.Duration) int {
+	w.toggleMu.Lock()
+	defer w.toggleMu.Unlock()
+	toggles := append(w.redstoneToggles[pos], w.currentTick.Load()+d.Nanoseconds()/int64(time.Second/20))
+	w.redstoneToggles[pos] = toggles
+	return len(toggles)
+}
+
+// RedstoneToggles returns the amount of toggles of the redstone component at the position passed that are
+// currently remembered. Toggles are recorded using World.ToggleRedstone.
+func (w *World) RedstoneToggles(pos BlockPos) int {
+	w.toggleMu.Lock()
+	defer w.toggleMu.Unlock()
+	return len(w.redstoneToggles[pos])
+}
+
+// tickRedstoneToggles forgets all toggles recorded using World.ToggleRedstone that expired at the tick
+// passed.
+func (w *World) tickRedstoneToggles(tick int64) {
+	w.toggleMu.Lock()
+	defer w.toggleMu.Unlock()
+	for pos, toggles := range w.redstoneToggles {
+		for len(toggles) > 0 && toggles[0] <= tick {
+			toggles = toggles[1:]
+		}
+		if len(toggles) == 0 {
+			delete(w.redstoneToggles, pos)
+			continue
+		}
+		w.redstoneToggles[pos] = toggles
+	}
+}
@@ -38,3 +38,27 @@ type sound struct{}
 
 // Play ...
 func (sound) Play(*world.World, mgl64.Vec3) {}
+
+// Explosion is played when an explosion happens, such as when TNT explodes.
+type Explosion struct{ sound }
+
+// Ignite is a sound played when using a flint & steel to ignite a block or TNT.
+type Ignite struct{ sound }
+
+// TNT is a sound played when TNT is ignited.
+type TNT struct{ sound }
+
+// PowerOn is a sound played when a redstone component, such as a lever or a button, is turned on.
+type PowerOn struct{ sound }
+
+// PowerOff is a sound played when a redstone component, such as a lever or a button, is turned off.
+type PowerOff struct{ sound }
+
+// DoorOpen is a sound played when a door, trapdoor or fence gate is opened.
+type DoorOpen struct{ sound }
+
+// DoorClose is a sound played when a door, trapdoor or fence gate is closed.
+type DoorClose struct{ sound }
+
+// AnvilLand is a sound played when a falling anvil lands on the ground.
+type AnvilLand struct{ sound }
@@ -8,3 +8,9 @@ type Attack struct {
 
 	sound
 }
+
+// Teleport is a sound played when an entity teleports, for example using an ender pearl.
+type Teleport struct{ sound }
+
+// Thunder is a sound played when lightning strikes the ground.
+type Thunder struct{ sound }
@@ -32,3 +32,12 @@ type BucketEmpty struct {
 
 	sound
 }
+
+// ItemThrow is a sound played when an entity throws an item, such as a snowball or an egg.
+type ItemThrow struct{ sound }
+
+// BowShoot is a sound played when an entity shoots an arrow using a bow.
+type BowShoot struct{ sound }
+
+// ArrowHit is a sound played when an arrow hits a block or an entity.
+type ArrowHit struct{ sound }
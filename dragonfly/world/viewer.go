@@ -5,6 +5,7 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/entity/action"
 	"github.com/df-mc/dragonfly/dragonfly/entity/state"
 	"github.com/df-mc/dragonfly/dragonfly/world/chunk"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/google/uuid"
 )
@@ -32,6 +33,12 @@ type Viewer interface {
 	// ViewTime views the time of the world. It is called every time the time is changed or otherwise every
 	// second.
 	ViewTime(time int)
+	// ViewWeather views the weather of the world. It is called every time it starts or stops raining or
+	// thundering.
+	ViewWeather(raining, thunder bool)
+	// ViewGameRule views the value of a game rule of the world. It is called every time a game rule is
+	// changed.
+	ViewGameRule(rule gamerule.GameRule, value interface{})
 	// ViewEntityItems views the items currently held by an entity that is able to equip items.
 	ViewEntityItems(e Entity)
 	// ViewEntityArmour views the items currently equipped as armour by the entity.
@@ -0,0 +1,212 @@
+package world
+
+import (
+	"github.com/df-mc/dragonfly/dragonfly/event"
+	"github.com/go-gl/mathgl/mgl64"
+	"math/rand"
+	"time"
+	_ "unsafe" // Imported for compiler directives.
+)
+
+// Raining checks if it is currently raining in the world.
+func (w *World) Raining() bool {
+	w.weatherMu.Lock()
+	defer w.weatherMu.Unlock()
+	return w.raining
+}
+
+// Thundering checks if it is currently thundering in the world. It can only thunder while it is also
+// raining, so Thundering always returns false if World.Raining() returns false.
+func (w *World) Thundering() bool {
+	w.weatherMu.Lock()
+	defer w.weatherMu.Unlock()
+	return w.raining && w.thundering
+}
+
+// RainingAt checks if it is raining at the position passed: Rain must be falling in the world and no blocks
+// may be above the position to stop the rain from reaching it.
+func (w *World) RainingAt(pos BlockPos) bool {
+	return w.Raining() && pos[1] >= w.HighestBlock(pos[0], pos[2])
+}
+
+// StartRaining makes it start raining in the world. The rain lasts for the duration passed, after which it
+// stops again. If it is already raining, only the duration of the rain is changed.
+// StartRaining calls Handler.HandleRainChange if it was not already raining, which may cancel the rain.
+func (w *World) StartRaining(dur time.Duration) {
+	w.setRaining(true, durationTicks(dur))
+}
+
+// StopRaining stops the rain in the world, and with it any thunder. The weather remains clear for a random
+// duration, after which it starts raining again.
+// StopRaining calls Handler.HandleRainChange if it was raining, which may cancel stopping the rain.
+func (w *World) StopRaining() {
+	w.StopThundering()
+	w.setRaining(false, weatherTicks(false, false))
+}
+
+// StartThundering makes it start thundering in the world. Because it can only thunder when it is raining,
+// StartThundering also starts the rain if it was not yet raining. The thunder and the rain last for at least
+// the duration passed. During a thunderstorm, lightning strikes randomly in the world.
+// StartThundering calls Handler.HandleThunderChange if it was not already thundering, which may cancel the
+// thunder.
+func (w *World) StartThundering(dur time.Duration) {
+	ticks := durationTicks(dur)
+
+	w.weatherMu.Lock()
+	rainTicks := w.rainTime
+	if !w.raining || rainTicks < ticks {
+		rainTicks = ticks
+	}
+	w.weatherMu.Unlock()
+
+	if w.setRaining(true, rainTicks) {
+		w.setThundering(true, ticks)
+	}
+}
+
+// StopThundering stops the thunder in the world. The rain, if any, continues to fall. The thunder remains
+// absent for a random duration, after which it starts thundering again.
+// StopThundering calls Handler.HandleThunderChange if it was thundering, which may cancel stopping the
+// thunder.
+func (w *World) StopThundering() {
+	w.setThundering(false, weatherTicks(true, false))
+}
+
+// StrikeLightning strikes lightning at the position passed. The lightning damages entities close to it and
+// may set blocks around it on fire.
+func (w *World) StrikeLightning(pos mgl64.Vec3) {
+	w.AddEntity(entity_newLightning(pos))
+}
+
+// setRaining starts or stops the rain in the world. The state lasts for the amount of ticks passed. If the
+// rain starts or stops, the Handler of the world is called, which may cancel the change. setRaining returns
+// false if this happened.
+func (w *World) setRaining(raining bool, ticks int64) bool {
+	w.weatherMu.Lock()
+	changed := w.raining != raining
+	w.weatherMu.Unlock()
+
+	ctx := event.C()
+	if changed {
+		w.Handler().HandleRainChange(ctx, raining)
+	}
+	success := false
+	ctx.Continue(func() {
+		success = true
+		w.weatherMu.Lock()
+		w.raining, w.rainTime = raining, ticks
+		w.weatherMu.Unlock()
+		if changed {
+			w.viewWeather()
+		}
+	})
+	return success
+}
+
+// setThundering starts or stops the thunder in the world. The state lasts for the amount of ticks passed. If
+// the thunder starts or stops, the Handler of the world is called, which may cancel the change. setThundering
+// returns false if this happened.
+func (w *World) setThundering(thundering bool, ticks int64) bool {
+	w.weatherMu.Lock()
+	changed := w.thundering != thundering
+	w.weatherMu.Unlock()
+
+	ctx := event.C()
+	if changed {
+		w.Handler().HandleThunderChange(ctx, thundering)
+	}
+	success := false
+	ctx.Continue(func() {
+		success = true
+		w.weatherMu.Lock()
+		w.thundering, w.thunderTime = thundering, ticks
+		w.weatherMu.Unlock()
+		if changed {
+			w.viewWeather()
+		}
+	})
+	return success
+}
+
+// tickWeather ticks the weather of the world. When the rain or thunder runs out of time, it is started or
+// stopped for a new random duration. If the change is cancelled by the Handler, the current weather is kept
+// for a new random duration instead.
+func (w *World) tickWeather() {
+	w.weatherMu.Lock()
+	w.rainTime--
+	w.thunderTime--
+	raining, rainDone := w.raining, w.rainTime <= 0
+	thundering, thunderDone := w.thundering, w.thunderTime <= 0
+	w.weatherMu.Unlock()
+
+	if thunderDone && !w.setThundering(!thundering, weatherTicks(!thundering, true)) {
+		w.weatherMu.Lock()
+		w.thunderTime = weatherTicks(thundering, true)
+		w.weatherMu.Unlock()
+	}
+	if rainDone && !w.setRaining(!raining, weatherTicks(!raining, false)) {
+		w.weatherMu.Lock()
+		w.rainTime = weatherTicks(raining, false)
+		w.weatherMu.Unlock()
+	}
+}
+
+// tickLightning makes lightning strike in loaded chunks of the world during a thunderstorm. Every tick, each
+// loaded chunk has a chance of 1 in 100000 to be struck at the highest block of a random column.
+func (w *World) tickLightning() {
+	if !w.Thundering() {
+		return
+	}
+	w.chunkMu.RLock()
+	for pos, c := range w.chunks {
+		if w.r.Intn(100000) != 0 {
+			continue
+		}
+		x, z := uint8(w.r.Intn(16)), uint8(w.r.Intn(16))
+		c.RLock()
+		y := int(c.HighestBlock(x, z)) + 1
+		c.RUnlock()
+		w.lightningStrikes = append(w.lightningStrikes, mgl64.Vec3{float64(pos[0]<<4) + float64(x) + 0.5, float64(y), float64(pos[1]<<4) + float64(z) + 0.5})
+	}
+	w.chunkMu.RUnlock()
+
+	for _, pos := range w.lightningStrikes {
+		w.StrikeLightning(pos)
+	}
+	w.lightningStrikes = w.lightningStrikes[:0]
+}
+
+// viewWeather shows the current weather of the world to all of its viewers.
+func (w *World) viewWeather() {
+	raining, thundering := w.Raining(), w.Thundering()
+	for _, viewer := range w.allViewers() {
+		viewer.ViewWeather(raining, thundering)
+	}
+}
+
+// weatherTicks returns a random amount of ticks that rain, or thunder if thunder is true, lasts if active is
+// true, or the amount of ticks that it stays absent if active is false.
+func weatherTicks(active, thunder bool) int64 {
+	switch {
+	case active && thunder:
+		return int64(rand.Intn(12000) + 3600)
+	case active:
+		return int64(rand.Intn(12000) + 12000)
+	}
+	return int64(rand.Intn(168000) + 12000)
+}
+
+// durationTicks converts a duration to an amount of ticks, with a minimum of one tick.
+func durationTicks(dur time.Duration) int64 {
+	if ticks := int64(dur / (time.Second / 20)); ticks > 0 {
+		return ticks
+	}
+	return 1
+}
+
+// The following functions use the go:linkname directive in order to spawn lightning without the world
+// package having to import the entity package.
+
+//go:linkname entity_newLightning github.com/df-mc/dragonfly/dragonfly/entity.newLightning
+//noinspection ALL
+func entity_newLightning(pos mgl64.Vec3) Entity
@@ -7,10 +7,13 @@ import (
 	"github.com/df-mc/dragonfly/dragonfly/world/chunk"
 	"github.com/df-mc/dragonfly/dragonfly/world/difficulty"
 	"github.com/df-mc/dragonfly/dragonfly/world/gamemode"
+	"github.com/df-mc/dragonfly/dragonfly/world/gamerule"
 	"github.com/go-gl/mathgl/mgl64"
 	"github.com/sirupsen/logrus"
 	"go.uber.org/atomic"
+	"math"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 )
@@ -36,6 +39,13 @@ type World struct {
 	difficultyMu sync.RWMutex
 	difficulty   difficulty.Difficulty
 
+	gameRuleMu sync.RWMutex
+	gameRules  map[gamerule.GameRule]interface{}
+
+	weatherMu             sync.Mutex
+	raining, thundering   bool
+	rainTime, thunderTime int64
+
 	blockMu      sync.RWMutex
 	entityBlocks map[ChunkPos]map[BlockPos]Block
 
@@ -67,13 +77,24 @@ type World struct {
 	randomTickSpeed atomic.Uint32
 
 	updateMu sync.Mutex
-	// blockUpdates is a map of tick time values indexed by the block position at which an update is
-	// scheduled. If the current tick exceeds the tick value passed, the block update will be performed
+	// blockUpdates is a map of scheduled updates indexed by the block position at which an update is
+	// scheduled. If the current tick exceeds the tick value of the update, the block update will be performed
 	// and the entry will be removed from the map.
-	blockUpdates    map[BlockPos]int64
-	updatePositions []BlockPos
-
-	toTick []toTick
+	blockUpdates map[BlockPos]scheduledUpdate
+	// updateCount is the amount of block updates that were scheduled. It is used to execute scheduled updates
+	// in the order that they were scheduled in.
+	updateCount     int64
+	updatePositions []scheduledUpdate
+
+	toggleMu sync.Mutex
+	// redstoneToggles holds the ticks at which the toggles of redstone components recorded using
+	// World.ToggleRedstone expire, indexed by the position of the component. Expired toggles are removed
+	// every tick.
+	redstoneToggles map[BlockPos][]int64
+
+	toTick           []toTick
+	blockEntities    []blockEntityTick
+	lightningStrikes []mgl64.Vec3
 
 	chunkLoadMu sync.Mutex
 }
@@ -88,7 +109,9 @@ func New(log *logrus.Logger, simulationDistance int) *World {
 		viewers:         map[ChunkPos][]Viewer{},
 		entities:        map[ChunkPos][]Entity{},
 		entityBlocks:    map[ChunkPos]map[BlockPos]Block{},
-		blockUpdates:    map[BlockPos]int64{},
+		blockUpdates:    map[BlockPos]scheduledUpdate{},
+		redstoneToggles: map[BlockPos][]int64{},
+		gameRules:       map[gamerule.GameRule]interface{}{},
 		defaultGameMode: gamemode.Survival{},
 		difficulty:      difficulty.Normal{},
 		prov:            NoIOProvider{},
@@ -102,6 +125,8 @@ func New(log *logrus.Logger, simulationDistance int) *World {
 		stopTick:        ctx,
 		cancelTick:      cancel,
 		name:            *atomic.NewString("World"),
+		rainTime:        weatherTicks(false, false),
+		thunderTime:     weatherTicks(false, true),
 	}
 	w.initChunkCache()
 	go w.startTicking()
@@ -239,6 +264,28 @@ func (w *World) setBlockInChunk(c *chunk.Chunk, pos BlockPos, b Block, chunkPos
 	return nil
 }
 
+// SignText returns the text of the sign at the position passed. If no sign or other block holding text was
+// found at the position, the bool returned is false.
+func (w *World) SignText(pos BlockPos) (string, bool) {
+	h, ok := w.Block(pos).(TextHolder)
+	if !ok {
+		return "", false
+	}
+	return h.HeldText(), true
+}
+
+// SetSignText changes the text of the sign at the position passed and updates it for all viewers. Lines of
+// the text are separated by a newline. If no sign or other block holding text was found at the position,
+// SetSignText returns false and nothing happens.
+func (w *World) SetSignText(pos BlockPos, text string) bool {
+	h, ok := w.Block(pos).(TextHolder)
+	if !ok {
+		return false
+	}
+	w.SetBlock(pos, h.WithText(text))
+	return true
+}
+
 // breakParticle has its value set in the block_internal package.
 var breakParticle func(b Block) Particle
 
@@ -254,6 +301,9 @@ func (w *World) BreakBlock(pos BlockPos) {
 	} else {
 		w.doBlockUpdatesAround(pos)
 	}
+	if _, ok := old.(RedstoneEmitter); ok {
+		w.UpdateRedstone(pos)
+	}
 }
 
 // PlaceBlock places a block at the position passed. Unlike when using SetBlock, PlaceBlock also schedules
@@ -271,9 +321,12 @@ func (w *World) PlaceBlock(pos BlockPos, b Block) {
 	w.SetBlock(pos, b)
 	if liquid != nil {
 		w.SetLiquid(pos, liquid)
-		return
+	} else {
+		w.SetLiquid(pos, nil)
+	}
+	if _, ok := b.(RedstoneEmitter); ok {
+		w.UpdateRedstone(pos)
 	}
-	w.SetLiquid(pos, nil)
 }
 
 // BuildStructure builds a Structure passed at a specific position in the world. Unlike SetBlock, it takes a
@@ -287,7 +340,6 @@ func (w *World) BuildStructure(pos BlockPos, s Structure) {
 	width, height, length := dim[0], dim[1], dim[2]
 	maxX, maxZ := pos[0]+width, pos[2]+length
 
-	w.blockMu.Lock()
 	for chunkX := pos[0] >> 4; chunkX < ((pos[0]+width)>>4)+1; chunkX++ {
 		for chunkZ := pos[2] >> 4; chunkZ < ((pos[2]+length)>>4)+1; chunkZ++ {
 			// We approach this on a per-chunk basis, so that we can keep only one chunk in memory at a time
@@ -298,13 +350,15 @@ func (w *World) BuildStructure(pos BlockPos, s Structure) {
 			c, err := w.chunk(chunkPos, false)
 			if err != nil {
 				w.log.Errorf("error loading chunk for structure: %v", err)
+				continue
 			}
 			f := func(x, y, z int) Block {
-				if x>>4 == chunkX && z>>4 == chunkZ {
-					b, _ := w.blockInChunk(c, BlockPos{x, y, z}, chunkPos)
+				actual := BlockPos{pos[0] + x, pos[1] + y, pos[2] + z}
+				if actual[0]>>4 == chunkX && actual[2]>>4 == chunkZ {
+					b, _ := w.blockInChunk(c, actual, chunkPos)
 					return b
 				}
-				return w.Block(BlockPos{x, y, z})
+				return w.Block(actual)
 			}
 
 			baseX, baseZ := chunkX<<4, chunkZ<<4
@@ -329,21 +383,25 @@ func (w *World) BuildStructure(pos BlockPos, s Structure) {
 						}
 						placePos := BlockPos{xOffset, y + pos[1], zOffset}
 						if b := s.At(xOffset-pos[0], y, zOffset-pos[2], f); b != nil {
+							w.blockMu.Lock()
 							if err := w.setBlockInChunk(c, placePos, b, chunkPos); err != nil {
 								w.log.Errorf("error setting block of structure: %v", err)
 							}
+							w.blockMu.Unlock()
 						}
 					}
 				}
 			}
 			// After setting all blocks of the structure within a single chunk, we show the new chunk to all
 			// viewers once, and unlock it.
+			w.blockMu.RLock()
 			for _, viewer := range w.chunkViewers(chunkPos) {
 				viewer.ViewChunk(chunkPos, c, w.entityBlocks[chunkPos])
 			}
+			w.blockMu.RUnlock()
+			c.Unlock()
 		}
 	}
-	w.blockMu.Unlock()
 }
 
 // Liquid attempts to return any liquid block at the position passed. This liquid may be in the foreground or
@@ -504,6 +562,18 @@ func (w *World) additionalLiquid(pos BlockPos) (Liquid, bool) {
 	return liq, ok
 }
 
+// HighestBlock returns the y value of the highest non-air block at the x and z values passed. If the column
+// holds only air blocks, 0 is returned.
+func (w *World) HighestBlock(x, z int) int {
+	c, err := w.chunk(ChunkPos{int32(x >> 4), int32(z >> 4)}, true)
+	if err != nil {
+		return 0
+	}
+	y := c.HighestBlock(uint8(x), uint8(z))
+	c.RUnlock()
+	return int(y)
+}
+
 // Light returns the light level at the position passed. This is the highest of the sky and block light.
 // The light value returned is a value in the range 0-15, where 0 means there is no light present, whereas
 // 15 means the block is fully lit.
@@ -537,18 +607,24 @@ func (w *World) SetTime(new int) {
 	}
 }
 
+// CurrentTick returns the current tick of the world. The tick is increased by one every 20th of a second,
+// regardless of whether time is stopped.
+func (w *World) CurrentTick() int64 {
+	return w.currentTick.Load()
+}
+
 // StopTime stops the time in the world. When called, the time will no longer cycle and the world will remain
 // at the time when StopTime is called. The time may be restarted by calling World.StartTime().
-// StopTime will not do anything if the time is already stopped.
+// StopTime sets the gamerule.DoDaylightCycle game rule to false.
 func (w *World) StopTime() {
-	w.timeStopped.Store(true)
+	w.SetGameRule(gamerule.DoDaylightCycle{}, false)
 }
 
 // StartTime restarts the time in the world. When called, the time will start cycling again and the day/night
 // cycle will continue. The time may be stopped again by calling World.StopTime().
-// StartTime will not do anything if the time is already started.
+// StartTime sets the gamerule.DoDaylightCycle game rule to true.
 func (w *World) StartTime() {
-	w.timeStopped.Store(false)
+	w.SetGameRule(gamerule.DoDaylightCycle{}, true)
 }
 
 // AddParticle spawns a particle at a given position in the world. Viewers that are viewing the chunk will be
@@ -638,6 +714,7 @@ func (w *World) RemoveEntity(e Entity) {
 	if len(n) == 0 {
 		// The entity is the last in the chunk, so we can delete the value from the map.
 		delete(w.entities, chunkPos)
+		w.entityMu.Unlock()
 		return
 	}
 	w.entities[chunkPos] = n
@@ -675,6 +752,18 @@ func (w *World) EntitiesWithin(aabb physics.AABB) []Entity {
 	return m
 }
 
+// Entities returns a list of all entities currently in the loaded chunks of the world.
+func (w *World) Entities() []Entity {
+	w.entityMu.RLock()
+	defer w.entityMu.RUnlock()
+
+	m := make([]Entity, 0, len(w.entities)*2)
+	for _, chunkEntities := range w.entities {
+		m = append(m, chunkEntities...)
+	}
+	return m
+}
+
 // OfEntity attempts to return a world that an entity is currently in. If the entity was not currently added
 // to a world, the world returned is nil and the bool returned is false.
 func OfEntity(e Entity) (*World, bool) {
@@ -731,8 +820,9 @@ func (w *World) SetDifficulty(d difficulty.Difficulty) {
 // SetRandomTickSpeed sets the random tick speed of blocks. By default, each sub chunk has 3 blocks randomly
 // ticked per sub chunk, so the default value is 3. Setting this value to 0 will stop random ticking
 // altogether, while setting it higher results in faster ticking.
+// SetRandomTickSpeed sets the gamerule.RandomTickSpeed game rule to the value passed.
 func (w *World) SetRandomTickSpeed(v int) {
-	w.randomTickSpeed.Store(uint32(v))
+	w.SetGameRule(gamerule.RandomTickSpeed{}, v)
 }
 
 // ScheduleBlockUpdate schedules a block update at the position passed after a specific delay. If the block at
@@ -746,7 +836,12 @@ func (w *World) ScheduleBlockUpdate(pos BlockPos, delay time.Duration) {
 		w.updateMu.Unlock()
 		return
 	}
-	w.blockUpdates[pos] = w.currentTick.Load() + delay.Nanoseconds()/int64(time.Second/20)
+	w.updateCount++
+	w.blockUpdates[pos] = scheduledUpdate{
+		pos:   pos,
+		tick:  w.currentTick.Load() + delay.Nanoseconds()/int64(time.Second/20),
+		order: w.updateCount,
+	}
 	w.updateMu.Unlock()
 }
 
@@ -794,6 +889,25 @@ func (w *World) Provider(p Provider) {
 	w.difficultyMu.Unlock()
 	w.time.Store(p.LoadTime())
 	w.timeStopped.Store(!p.LoadTimeCycle())
+
+	raining, rainTime := p.LoadRaining()
+	thundering, thunderTime := p.LoadThundering()
+	if rainTime <= 0 {
+		rainTime = int(weatherTicks(raining, false))
+	}
+	if thunderTime <= 0 {
+		thunderTime = int(weatherTicks(thundering, true))
+	}
+	w.weatherMu.Lock()
+	w.raining, w.rainTime = raining, int64(rainTime)
+	w.thundering, w.thunderTime = thundering, int64(thunderTime)
+	w.weatherMu.Unlock()
+
+	for _, rule := range gamerule.All() {
+		if value, ok := p.LoadGameRule(rule); ok {
+			w.setGameRule(rule, value)
+		}
+	}
 	w.initChunkCache()
 }
 
@@ -869,6 +983,13 @@ func (w *World) Close() error {
 		w.difficultyMu.RLock()
 		w.provider().SaveDifficulty(w.difficulty)
 		w.difficultyMu.RUnlock()
+		w.weatherMu.Lock()
+		w.provider().SaveRaining(w.raining, int(w.rainTime))
+		w.provider().SaveThundering(w.thundering, int(w.thunderTime))
+		w.weatherMu.Unlock()
+		for rule, value := range w.GameRules() {
+			w.provider().SaveGameRule(rule, value)
+		}
 	}
 
 	w.log.Debug("Closing provider...")
@@ -915,24 +1036,45 @@ func (w *World) tick() {
 			viewer.ViewTime(int(w.time.Load()))
 		}
 	}
+	if w.GameRule(gamerule.DoWeatherCycle{}).(bool) {
+		w.tickWeather()
+	}
+	w.tickLightning()
 	w.tickEntities(tick)
+	w.tickBlockEntities(tick)
 	w.tickRandomBlocks(viewers)
 	w.tickScheduledBlocks(tick)
+	w.tickRedstoneToggles(tick)
+}
+
+// scheduledUpdate is a block update scheduled using World.ScheduleBlockUpdate.
+type scheduledUpdate struct {
+	pos         BlockPos
+	tick, order int64
 }
 
 // tickScheduledBlocks executes scheduled block ticks in chunks that are still loaded at the time of
-// execution.
+// execution. Updates are executed in the order that they were scheduled to be executed in, so that blocks
+// such as redstone components behave the same every time.
 func (w *World) tickScheduledBlocks(tick int64) {
 	w.updateMu.Lock()
-	for pos, scheduledTick := range w.blockUpdates {
-		if scheduledTick <= tick {
-			w.updatePositions = append(w.updatePositions, pos)
+	for pos, update := range w.blockUpdates {
+		if update.tick <= tick {
+			w.updatePositions = append(w.updatePositions, update)
 			delete(w.blockUpdates, pos)
 		}
 	}
 	w.updateMu.Unlock()
 
-	for _, pos := range w.updatePositions {
+	sort.Slice(w.updatePositions, func(i, j int) bool {
+		a, b := w.updatePositions[i], w.updatePositions[j]
+		if a.tick != b.tick {
+			return a.tick < b.tick
+		}
+		return a.order < b.order
+	})
+	for _, update := range w.updatePositions {
+		pos := update.pos
 		if ticker, ok := w.Block(pos).(ScheduledTicker); ok {
 			ticker.ScheduledTick(pos, w)
 		}
@@ -946,6 +1088,31 @@ func (w *World) tickScheduledBlocks(tick int64) {
 	w.updatePositions = w.updatePositions[:0]
 }
 
+// blockEntityTick is a struct used to keep track of blocks with block entities that need to be ticked.
+type blockEntityTick struct {
+	b   TickerBlock
+	pos BlockPos
+}
+
+// tickBlockEntities ticks all blocks with block entities in loaded chunks that implement the TickerBlock
+// interface, such as furnaces.
+func (w *World) tickBlockEntities(tick int64) {
+	w.blockMu.RLock()
+	for _, blocks := range w.entityBlocks {
+		for pos, b := range blocks {
+			if ticker, ok := b.(TickerBlock); ok {
+				w.blockEntities = append(w.blockEntities, blockEntityTick{b: ticker, pos: pos})
+			}
+		}
+	}
+	w.blockMu.RUnlock()
+
+	for _, a := range w.blockEntities {
+		a.b.Tick(tick, a.pos, w)
+	}
+	w.blockEntities = w.blockEntities[:0]
+}
+
 // toTick is a struct used to keep track of blocks that need to be ticked upon a random tick.
 type toTick struct {
 	b   RandomTicker
@@ -1085,6 +1252,25 @@ func (w *World) tickEntities(tick int64) {
 		// We gather entities to tick and tick them later, so that the lock on the entity mutex is no longer
 		// active.
 		ticker.Tick(tick)
+		if _, ok := OfEntity(ticker.(Entity)); ok {
+			w.entityInside(ticker.(Entity))
+		}
+	}
+}
+
+// entityInside calls EntityInside on all blocks that the entity passed is currently inside of.
+func (w *World) entityInside(e Entity) {
+	aabb := e.AABB().Translate(e.Position())
+	min, max := aabb.Min(), aabb.Max()
+	for x := int(math.Floor(min[0])); x <= int(math.Floor(max[0])); x++ {
+		for y := int(math.Floor(min[1])); y <= int(math.Floor(max[1])); y++ {
+			for z := int(math.Floor(min[2])); z <= int(math.Floor(max[2])); z++ {
+				pos := BlockPos{x, y, z}
+				if insider, ok := w.Block(pos).(EntityInsider); ok {
+					insider.EntityInside(pos, w, e)
+				}
+			}
+		}
 	}
 }
 
@@ -1232,11 +1418,14 @@ func (w *World) chunk(pos ChunkPos, readOnly bool) (*chunk.Chunk, error) {
 	var needsLight bool
 	var err error
 
+	var entities []Entity
+
 	w.chunkLoadMu.Lock()
 	c, ok := w.chunkFromCache(pos)
 	if !ok {
-		c, err = w.loadChunk(pos)
+		c, entities, err = w.loadChunk(pos)
 		if err != nil {
+			w.chunkLoadMu.Unlock()
 			return nil, err
 		}
 		w.storeChunkToCache(pos, c)
@@ -1247,6 +1436,11 @@ func (w *World) chunk(pos ChunkPos, readOnly bool) (*chunk.Chunk, error) {
 	if needsLight {
 		w.calculateLight(c, pos)
 	}
+	// Entities loaded with the chunk are only added once the chunk is stored in the cache, so that adding
+	// them does not attempt to load the chunk again.
+	for _, e := range entities {
+		w.AddEntity(e)
+	}
 
 	if readOnly {
 		c.RLock()
@@ -1257,33 +1451,30 @@ func (w *World) chunk(pos ChunkPos, readOnly bool) (*chunk.Chunk, error) {
 }
 
 // loadChunk attempts to load a chunk from the provider, or generates a chunk if one doesn't currently exist.
-func (w *World) loadChunk(pos ChunkPos) (c *chunk.Chunk, err error) {
+// The entities returned were loaded together with the chunk and should be added to the world once the chunk
+// is stored in the cache.
+func (w *World) loadChunk(pos ChunkPos) (c *chunk.Chunk, entities []Entity, err error) {
 	var found bool
 	c, found, err = w.provider().LoadChunk(pos)
 	if err != nil {
-		return nil, fmt.Errorf("error loading chunk %v: %w", pos, err)
+		return nil, nil, fmt.Errorf("error loading chunk %v: %w", pos, err)
 	}
 	if !found {
 		// The provider doesn't have a chunk saved at this position, so we generate a new one.
 		c = chunk.New()
 		w.generator().GenerateChunk(pos, c)
 	} else {
-		entities, err := w.provider().LoadEntities(pos)
+		entities, err = w.provider().LoadEntities(pos)
 		if err != nil {
-			return nil, fmt.Errorf("error loading entities of chunk %v: %w", pos, err)
-		}
-		if len(entities) != 0 {
-			for _, e := range entities {
-				w.AddEntity(e)
-			}
+			return nil, nil, fmt.Errorf("error loading entities of chunk %v: %w", pos, err)
 		}
 		blockEntities, err := w.provider().LoadBlockNBT(pos)
 		if err != nil {
-			return nil, fmt.Errorf("error loading block entities of chunk %v: %w", pos, err)
+			return nil, nil, fmt.Errorf("error loading block entities of chunk %v: %w", pos, err)
 		}
 		w.loadIntoBlocks(c, pos, blockEntities)
 	}
-	return c, nil
+	return c, entities, nil
 }
 
 // calculateLight calculates the light in the chunk passed and spreads the light of any of the surrounding
@@ -0,0 +1,152 @@
+package dragonfly
+
+import (
+	"errors"
+	"fmt"
+	"github.com/df-mc/dragonfly/dragonfly/world"
+	"github.com/df-mc/dragonfly/dragonfly/world/mcdb"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// WorldManager manages the worlds of a Server. Worlds may be created, loaded, unloaded and looked up by their
+// name. Every world managed by the WorldManager has its own provider and generator.
+// The WorldManager always holds a default world, which is the world that players are spawned in when they
+// join. This world cannot be unloaded.
+type WorldManager struct {
+	s *Server
+
+	mu           sync.RWMutex
+	defaultWorld *world.World
+	worlds       map[string]*world.World
+}
+
+// newWorldManager creates a new WorldManager for the Server passed, holding the default world passed.
+func newWorldManager(s *Server, defaultWorld *world.World) *WorldManager {
+	return &WorldManager{s: s, defaultWorld: defaultWorld, worlds: map[string]*world.World{}}
+}
+
+// DefaultWorld returns the default world of the WorldManager. Players are spawned in this world when they
+// join the server.
+func (m *WorldManager) DefaultWorld() *world.World {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.defaultWorld
+}
+
+// World looks up a world with the name passed. If found, the world is returned and the bool returned is true.
+// If not, the bool returned is false and the world is nil.
+func (m *WorldManager) World(name string) (*world.World, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	w, ok := m.worlds[name]
+	return w, ok
+}
+
+// Worlds returns a list of all worlds currently loaded by the WorldManager, including the default world.
+func (m *WorldManager) Worlds() []*world.World {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	worlds := make([]*world.World, 0, len(m.worlds))
+	for _, w := range m.worlds {
+		worlds = append(worlds, w)
+	}
+	return worlds
+}
+
+// CreateWorld creates a new world with the name passed, storing its data in the folder passed. The Generator
+// passed is used to generate chunks of the world that do not yet exist. If a world with the same name is
+// already loaded, or if a world already exists in the folder passed, an error is returned.
+func (m *WorldManager) CreateWorld(name, folder string, gen world.Generator) (*world.World, error) {
+	if _, ok := m.World(name); ok {
+		return nil, fmt.Errorf("world '%v' is already loaded", name)
+	}
+	if _, err := os.Stat(filepath.Join(folder, "level.dat")); err == nil {
+		return nil, fmt.Errorf("a world already exists in folder %v", folder)
+	}
+	p, err := mcdb.New(folder)
+	if err != nil {
+		return nil, fmt.Errorf("error creating world: %w", err)
+	}
+	p.SetWorldName(name)
+	return m.add(p, gen)
+}
+
+// LoadWorld loads the world stored in the folder passed. The Generator passed is used to generate chunks of
+// the world that do not yet exist. If a world with the same name as the one loaded is already loaded, an
+// error is returned.
+func (m *WorldManager) LoadWorld(folder string, gen world.Generator) (*world.World, error) {
+	p, err := mcdb.New(folder)
+	if err != nil {
+		return nil, fmt.Errorf("error loading world: %w", err)
+	}
+	return m.add(p, gen)
+}
+
+// UnloadWorld unloads the world passed. Players currently in the world are transferred to the spawn of the
+// default world and players with a spawn point in the world have it reset, after which the world is saved and
+// its provider is closed. The default world cannot be unloaded.
+func (m *WorldManager) UnloadWorld(w *world.World) error {
+	if w == m.DefaultWorld() {
+		return errors.New("the default world cannot be unloaded")
+	}
+	m.mu.Lock()
+	if m.worlds[w.Name()] != w {
+		m.mu.Unlock()
+		return fmt.Errorf("world '%v' is not loaded", w.Name())
+	}
+	delete(m.worlds, w.Name())
+	m.mu.Unlock()
+
+	def := m.DefaultWorld()
+	for _, p := range m.s.Players() {
+		if p.World() == w {
+			p.TransferToWorld(def, def.Spawn().Vec3Middle())
+		}
+		if spawnWorld, _, ok := p.SpawnPoint(); ok && spawnWorld == w {
+			p.ResetSpawnPoint()
+		}
+	}
+	m.s.log.Debugf("Unloading world '%v'...", w.Name())
+	return w.Close()
+}
+
+// Close closes all worlds of the WorldManager, including the default world, saving them and closing their
+// providers.
+func (m *WorldManager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, w := range m.worlds {
+		m.s.log.Debugf("Closing world '%v'...", name)
+		if err := w.Close(); err != nil {
+			return err
+		}
+		delete(m.worlds, name)
+	}
+	return nil
+}
+
+// add creates a new world using the Provider and Generator passed and adds it to the WorldManager.
+func (m *WorldManager) add(p world.Provider, gen world.Generator) (*world.World, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.worlds[p.WorldName()]; ok {
+		_ = p.Close()
+		return nil, fmt.Errorf("world '%v' is already loaded", p.WorldName())
+	}
+	w := world.New(m.s.log, m.s.c.World.SimulationDistance)
+	w.Provider(p)
+	w.Generator(gen)
+	m.worlds[w.Name()] = w
+	return w, nil
+}
+
+// register registers the world passed in the WorldManager using its current name.
+func (m *WorldManager) register(w *world.World) {
+	m.mu.Lock()
+	m.worlds[w.Name()] = w
+	m.mu.Unlock()
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/df-mc/dragonfly/dragonfly"
+	"github.com/df-mc/dragonfly/dragonfly/console"
 	"github.com/df-mc/dragonfly/dragonfly/player/chat"
 	"github.com/pelletier/go-toml"
 	"github.com/sirupsen/logrus"
@@ -35,6 +36,11 @@ func main() {
 	if err := server.Start(); err != nil {
 		log.Fatalln(err)
 	}
+	go func() {
+		if err := console.New(log, server.World()).Run(os.Stdin); err != nil {
+			log.Errorln(err)
+		}
+	}()
 
 	for {
 		if _, err := server.Accept(); err != nil {